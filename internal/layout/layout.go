@@ -0,0 +1,116 @@
+// Package layout evaluates the directories of a repository against layout profiles.
+//
+// A profile classifies directories, such as root modules or reusable modules, with patterns matched against
+// their path relative to the repository root, and lists the files every directory of that class must or must
+// not include. Directories are assigned to the first profile that matches them, and directories without
+// Terraform files or matching no profile are not evaluated. Each directory is evaluated on its own, so a
+// repository is covered by evaluating every directory TFLint inspects, as with tflint --recursive.
+package layout
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Profile is a class of directories and the files they must or must not include.
+// RequiredFiles and ForbiddenFiles are file name patterns in the syntax of filepath.Match.
+type Profile struct {
+	Name           string
+	Directories    []*regexp.Regexp
+	RequiredFiles  []string
+	ForbiddenFiles []string
+}
+
+// Violation is a file a directory is missing or should not include
+type Violation struct {
+	Profile string
+	// File is the required file pattern for missing files, and the file name for forbidden files
+	File      string
+	Forbidden bool
+}
+
+// Policy is an ordered list of profiles
+type Policy struct {
+	profiles []Profile
+}
+
+// New validates the file patterns of the profiles and returns a policy
+func New(profiles []Profile) (*Policy, error) {
+	for _, profile := range profiles {
+		for _, pattern := range append(append([]string{}, profile.RequiredFiles...), profile.ForbiddenFiles...) {
+			if _, err := filepath.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid file pattern %q in profile %q: %w", pattern, profile.Name, err)
+			}
+		}
+	}
+	return &Policy{profiles: profiles}, nil
+}
+
+// Classify returns the first profile whose patterns match the slash-separated directory path, or nil
+func (p *Policy) Classify(dir string) *Profile {
+	for i := range p.profiles {
+		for _, pattern := range p.profiles[i].Directories {
+			if pattern.MatchString(dir) {
+				return &p.profiles[i]
+			}
+		}
+	}
+	return nil
+}
+
+// Evaluate returns the violations of dir, classified by its slash-separated path relative to the repository root
+func (p *Policy) Evaluate(dir string, path string) ([]Violation, error) {
+	profile := p.Classify(path)
+	if profile == nil {
+		return nil, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{}
+	terraform := false
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		files = append(files, entry.Name())
+		if strings.HasSuffix(entry.Name(), ".tf") || strings.HasSuffix(entry.Name(), ".tf.json") {
+			terraform = true
+		}
+	}
+	if !terraform {
+		return nil, nil
+	}
+	sort.Strings(files)
+
+	violations := []Violation{}
+	for _, pattern := range profile.RequiredFiles {
+		if len(matching(pattern, files)) == 0 {
+			violations = append(violations, Violation{Profile: profile.Name, File: pattern})
+		}
+	}
+	for _, pattern := range profile.ForbiddenFiles {
+		for _, name := range matching(pattern, files) {
+			violations = append(violations, Violation{Profile: profile.Name, File: name, Forbidden: true})
+		}
+	}
+	return violations, nil
+}
+
+// matching returns the names matching the pattern. Patterns are validated by New.
+func matching(pattern string, names []string) []string {
+	matches := []string{}
+	for _, name := range names {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			matches = append(matches, name)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,109 @@
+package layout
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestPolicy_Evaluate(t *testing.T) {
+	root := t.TempDir()
+	for name, content := range map[string]string{
+		"environments/prod/main.tf":      "",
+		"environments/prod/outputs.tf":   "",
+		"environments/prod/prod.tfvars":  "",
+		"environments/dev/main.tf":       "",
+		"environments/dev/backend.tf":    "",
+		"modules/network/main.tf":        "",
+		"modules/network/backend.tf":     "",
+		"modules/network/docs/README.md": "",
+		"modules/empty/README.md":        "",
+		"scripts/bootstrap/bootstrap.tf": "",
+	} {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	policy, err := New([]Profile{
+		{
+			Name:           "root",
+			Directories:    []*regexp.Regexp{regexp.MustCompile(`^environments/[^/]*$`)},
+			RequiredFiles:  []string{"main.tf", "backend.tf"},
+			ForbiddenFiles: []string{"*.tfvars"},
+		},
+		{
+			Name:           "module",
+			Directories:    []*regexp.Regexp{regexp.MustCompile(`^modules/[^/]*$`)},
+			RequiredFiles:  []string{"main.tf", "README.md"},
+			ForbiddenFiles: []string{"backend.tf"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, expected := range map[string][]Violation{
+		"environments/prod": {
+			{Profile: "root", File: "backend.tf"},
+			{Profile: "root", File: "prod.tfvars", Forbidden: true},
+		},
+		"environments/dev": {},
+		"modules/network": {
+			{Profile: "module", File: "README.md"},
+			{Profile: "module", File: "backend.tf", Forbidden: true},
+		},
+		// Directories without Terraform files and unclassified directories are not evaluated
+		"modules/empty":     nil,
+		"scripts/bootstrap": nil,
+	} {
+		got, err := policy.Evaluate(filepath.Join(root, path), path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: expected %#v, got %#v", path, expected, got)
+		}
+	}
+}
+
+func TestPolicy_Classify(t *testing.T) {
+	policy, err := New([]Profile{
+		{Name: "root", Directories: []*regexp.Regexp{regexp.MustCompile(`^\.$`), regexp.MustCompile(`^stacks/`)}},
+		{Name: "module", Directories: []*regexp.Regexp{regexp.MustCompile(`^stacks/modules/`), regexp.MustCompile(`^modules/`)}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for dir, expected := range map[string]string{
+		".":                   "root",
+		"stacks/app":          "root",
+		"stacks/modules/vpc":  "root",
+		"modules/vpc":         "module",
+		"examples/vpc":        "",
+		"modules_backup/vpc":  "",
+		"stacks/modules/vpc/": "root",
+	} {
+		got := ""
+		if profile := policy.Classify(dir); profile != nil {
+			got = profile.Name
+		}
+		if got != expected {
+			t.Errorf("%s: expected profile %q, got %q", dir, expected, got)
+		}
+	}
+}
+
+func TestNew_InvalidPattern(t *testing.T) {
+	_, err := New([]Profile{{Name: "module", RequiredFiles: []string{"[main.tf"}}})
+	if err == nil {
+		t.Fatal("expected an error for an invalid file pattern")
+	}
+}
@@ -0,0 +1,61 @@
+// Package naming implements the naming conventions shared by the naming convention rules.
+//
+// A convention is either one of the format presets, snake_case, camelCase, PascalCase and kebab-case,
+// or a custom regular expression selected with the "custom" format.
+package naming
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Custom is the format that validates names against a custom regular expression
+const Custom = "custom"
+
+// formats are the supported presets, in the order they are listed in error messages
+var formats = []struct {
+	name    string
+	pattern *regexp.Regexp
+}{
+	{name: "snake_case", pattern: regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)},
+	{name: "camelCase", pattern: regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)},
+	{name: "PascalCase", pattern: regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)},
+	{name: "kebab-case", pattern: regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)},
+}
+
+// Convention is a compiled naming format used to validate names
+type Convention struct {
+	pattern     *regexp.Regexp
+	description string
+}
+
+// New compiles a format preset, or the custom regular expression when format is "custom"
+func New(format string, custom string) (*Convention, error) {
+	if format == Custom {
+		if custom == "" {
+			return nil, fmt.Errorf(`the "custom" format requires a custom regular expression`)
+		}
+		pattern, err := regexp.Compile(custom)
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom pattern %q: %w", custom, err)
+		}
+		return &Convention{pattern: pattern, description: fmt.Sprintf("RegExp: %s", custom)}, nil
+	}
+
+	for _, f := range formats {
+		if f.name == format {
+			return &Convention{pattern: f.pattern, description: fmt.Sprintf("format: %s", format)}, nil
+		}
+	}
+	return nil, fmt.Errorf("%q is an invalid format. Valid formats are snake_case, camelCase, PascalCase, kebab-case, and custom", format)
+}
+
+// Match returns whether the name satisfies the convention
+func (c *Convention) Match(name string) bool {
+	return c.pattern.MatchString(name)
+}
+
+// String returns a description of the convention for use in issue messages
+func (c *Convention) String() string {
+	return c.description
+}
@@ -0,0 +1,121 @@
+package naming
+
+import "testing"
+
+func TestConvention_Match(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Format   string
+		Custom   string
+		Accepted []string
+		Rejected []string
+	}{
+		{
+			Name:     "snake_case",
+			Format:   "snake_case",
+			Accepted: []string{"foo", "foo_bar", "foo2_bar3"},
+			Rejected: []string{"Foo", "fooBar", "foo-bar", "foo__bar", "_foo", "foo_", "2foo"},
+		},
+		{
+			Name:     "camelCase",
+			Format:   "camelCase",
+			Accepted: []string{"foo", "fooBar", "fooBAR2"},
+			Rejected: []string{"FooBar", "foo_bar", "foo-bar", "2foo"},
+		},
+		{
+			Name:     "PascalCase",
+			Format:   "PascalCase",
+			Accepted: []string{"Foo", "FooBar", "FooBAR2"},
+			Rejected: []string{"foo", "fooBar", "Foo_Bar", "Foo-Bar"},
+		},
+		{
+			Name:     "kebab-case",
+			Format:   "kebab-case",
+			Accepted: []string{"foo", "foo-bar", "foo2-bar3"},
+			Rejected: []string{"Foo", "fooBar", "foo_bar", "foo--bar", "foo-"},
+		},
+		{
+			Name:     "custom",
+			Format:   "custom",
+			Custom:   `^(app|svc)_[a-z]+$`,
+			Accepted: []string{"app_web", "svc_api"},
+			Rejected: []string{"web", "app_Web"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			convention, err := New(tc.Format, tc.Custom)
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			for _, name := range tc.Accepted {
+				if !convention.Match(name) {
+					t.Errorf("expected %q to match %s", name, convention)
+				}
+			}
+			for _, name := range tc.Rejected {
+				if convention.Match(name) {
+					t.Errorf("expected %q not to match %s", name, convention)
+				}
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Format   string
+		Custom   string
+		Expected string
+		Error    string
+	}{
+		{
+			Name:     "preset",
+			Format:   "PascalCase",
+			Expected: "format: PascalCase",
+		},
+		{
+			Name:     "custom",
+			Format:   "custom",
+			Custom:   `^[a-z]+$`,
+			Expected: "RegExp: ^[a-z]+$",
+		},
+		{
+			Name:   "custom without regular expression",
+			Format: "custom",
+			Error:  `the "custom" format requires a custom regular expression`,
+		},
+		{
+			Name:   "invalid custom regular expression",
+			Format: "custom",
+			Custom: `^[a-z`,
+			Error:  "invalid custom pattern \"^[a-z\": error parsing regexp: missing closing ]: `[a-z`",
+		},
+		{
+			Name:   "invalid format",
+			Format: "UPPER",
+			Error:  `"UPPER" is an invalid format. Valid formats are snake_case, camelCase, PascalCase, kebab-case, and custom`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			convention, err := New(tc.Format, tc.Custom)
+			if tc.Error != "" {
+				if err == nil || err.Error() != tc.Error {
+					t.Fatalf("expected error %q, got %v", tc.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if convention.String() != tc.Expected {
+				t.Errorf("expected %q, got %q", tc.Expected, convention.String())
+			}
+		})
+	}
+}
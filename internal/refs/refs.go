@@ -0,0 +1,197 @@
+// Package refs builds the reference graph of a module, the traversals found in its expressions
+// indexed by the object they reference and by the block they are declared in.
+//
+// Addresses follow Terraform's syntax: var.region, local.name, aws_vpc.main, data.aws_ami.ubuntu,
+// module.vpc and output.vpc_id.
+package refs
+
+import (
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Reference is a traversal found somewhere in the module, such as var.foo or data.aws_ami.ubuntu.id
+type Reference struct {
+	Traversal hcl.Traversal
+	Range     hcl.Range
+	// From is the address of the block the reference is declared in, e.g. "output.vpc_id" or "local.name"
+	// for an entry of a locals block. It is empty for references in JSON files and in blocks without an address.
+	From string
+}
+
+// Key returns the root name joined with up to depth-1 attribute steps, e.g. "data.aws_ami.ubuntu" for depth 3.
+// It returns an empty string if the traversal is shorter than depth.
+func (ref Reference) Key(depth int) string {
+	if len(ref.Traversal) < depth {
+		return ""
+	}
+	key := ref.Traversal.RootName()
+	for _, step := range ref.Traversal[1:depth] {
+		attr, ok := step.(hcl.TraverseAttr)
+		if !ok {
+			return ""
+		}
+		key += "." + attr.Name
+	}
+	return key
+}
+
+// Addr returns the address of the referenced object, e.g. "aws_vpc.main" for aws_vpc.main.id,
+// "data.aws_ami.ubuntu" or "module.vpc"
+func (ref Reference) Addr() string {
+	if ref.Traversal.RootName() == "data" {
+		return ref.Key(3)
+	}
+	return ref.Key(2)
+}
+
+// Within returns whether the reference lies inside the given range
+func (ref Reference) Within(rng hcl.Range) bool {
+	return ref.Range.Filename == rng.Filename && rng.ContainsOffset(ref.Range.Start.Byte)
+}
+
+// Graph is the set of references in a module
+type Graph struct {
+	refs []Reference
+	to   map[string][]Reference
+	from map[string][]Reference
+}
+
+// New indexes the references
+func New(refs []Reference) *Graph {
+	g := &Graph{
+		refs: refs,
+		to:   map[string][]Reference{},
+		from: map[string][]Reference{},
+	}
+	for _, ref := range refs {
+		if addr := ref.Addr(); addr != "" {
+			g.to[addr] = append(g.to[addr], ref)
+		}
+		if ref.From != "" {
+			g.from[ref.From] = append(g.from[ref.From], ref)
+		}
+	}
+	return g
+}
+
+// Build walks every expression of the module and returns its reference graph
+func Build(runner tflint.Runner) (*Graph, error) {
+	refs := []Reference{}
+	diags := runner.WalkExpressions(tflint.ExprWalkFunc(func(expr hcl.Expression) hcl.Diagnostics {
+		if _, ok := expr.(hclsyntax.Expression); ok {
+			// Native syntax is walked node by node, so only traversal nodes need collecting
+			if traversal, ok := expr.(*hclsyntax.ScopeTraversalExpr); ok {
+				refs = append(refs, Reference{Traversal: traversal.Traversal, Range: traversal.SrcRange})
+			}
+			return nil
+		}
+
+		// JSON syntax only passes top-level expressions
+		for _, traversal := range expr.Variables() {
+			refs = append(refs, Reference{Traversal: traversal, Range: traversal.SourceRange()})
+		}
+		return nil
+	}))
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return nil, err
+	}
+	scopes := declarations(files)
+	for i := range refs {
+		refs[i].From = declaringAddr(scopes[refs[i].Range.Filename], refs[i])
+	}
+
+	return New(refs), nil
+}
+
+// References returns every reference in the module
+func (g *Graph) References() []Reference {
+	return g.refs
+}
+
+// Referenced returns whether anything in the module references the object at addr
+func (g *Graph) Referenced(addr string) bool {
+	return len(g.to[addr]) > 0
+}
+
+// To returns the references to the object at addr, such as the uses of var.region
+func (g *Graph) To(addr string) []Reference {
+	return g.to[addr]
+}
+
+// From returns the references declared in the block at addr, such as what output.vpc_id references
+func (g *Graph) From(addr string) []Reference {
+	return g.from[addr]
+}
+
+// scope is the range of a declaration that references are attributed to
+type scope struct {
+	addr string
+	rng  hcl.Range
+}
+
+// declarations returns the addressable declarations in the native syntax files by filename, one for each entry
+// of locals blocks. Declarations do not overlap, and those of each file are sorted by position.
+func declarations(files map[string]*hcl.File) map[string][]scope {
+	scopes := map[string][]scope{}
+	for name, file := range files {
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		fileScopes := []scope{}
+		for _, block := range body.Blocks {
+			if block.Type == "locals" {
+				for _, attr := range block.Body.Attributes {
+					fileScopes = append(fileScopes, scope{addr: "local." + attr.Name, rng: attr.Range()})
+				}
+				continue
+			}
+			if addr := blockAddr(block); addr != "" {
+				fileScopes = append(fileScopes, scope{addr: addr, rng: block.Range()})
+			}
+		}
+		sort.Slice(fileScopes, func(i, j int) bool {
+			return fileScopes[i].rng.Start.Byte < fileScopes[j].rng.Start.Byte
+		})
+		scopes[name] = fileScopes
+	}
+	return scopes
+}
+
+// declaringAddr returns the address of the declaration the reference lies in, searching the sorted declarations
+// of its file, or an empty string if it lies in none
+func declaringAddr(scopes []scope, ref Reference) string {
+	// The last declaration starting at or before the reference is the only one that can contain it
+	i := sort.Search(len(scopes), func(i int) bool {
+		return scopes[i].rng.Start.Byte > ref.Range.Start.Byte
+	}) - 1
+	if i >= 0 && ref.Within(scopes[i].rng) {
+		return scopes[i].addr
+	}
+	return ""
+}
+
+// blockAddr returns the address of a top-level block, or an empty string for blocks without one such as terraform
+func blockAddr(block *hclsyntax.Block) string {
+	switch {
+	case block.Type == "resource" && len(block.Labels) == 2:
+		return block.Labels[0] + "." + block.Labels[1]
+	case block.Type == "data" && len(block.Labels) == 2:
+		return "data." + block.Labels[0] + "." + block.Labels[1]
+	case block.Type == "variable" && len(block.Labels) == 1:
+		return "var." + block.Labels[0]
+	case (block.Type == "module" || block.Type == "output") && len(block.Labels) == 1:
+		return block.Type + "." + block.Labels[0]
+	default:
+		return ""
+	}
+}
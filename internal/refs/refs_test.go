@@ -0,0 +1,139 @@
+package refs
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestBuild(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"main.tf": `
+variable "region" {
+  type = string
+}
+variable "unused" {
+  type = string
+}
+locals {
+  name   = "app-${var.region}"
+  prefix = local.name
+}
+data "aws_ami" "ubuntu" {
+  owners = [var.region]
+}
+resource "aws_instance" "web" {
+  ami  = data.aws_ami.ubuntu.id
+  tags = { Name = local.prefix }
+}
+module "vpc" {
+  source = "./vpc"
+  region = var.region
+}
+output "web_id" {
+  value = aws_instance.web.id
+}
+output "vpc_id" {
+  value = module.vpc.id
+}
+`,
+	})
+
+	g, err := Build(runner)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	referenced := map[string]bool{
+		"var.region":          true,
+		"var.unused":          false,
+		"local.name":          true,
+		"local.prefix":        true,
+		"data.aws_ami.ubuntu": true,
+		"aws_instance.web":    true,
+		"module.vpc":          true,
+	}
+	for addr, expected := range referenced {
+		if got := g.Referenced(addr); got != expected {
+			t.Errorf("Referenced(%q) = %t, want %t", addr, got, expected)
+		}
+	}
+
+	if got := len(g.To("var.region")); got != 3 {
+		t.Errorf("expected 3 references to var.region, got %d", got)
+	}
+
+	from := map[string][]string{
+		"local.name":          {"var.region"},
+		"local.prefix":        {"local.name"},
+		"data.aws_ami.ubuntu": {"var.region"},
+		"aws_instance.web":    {"data.aws_ami.ubuntu", "local.prefix"},
+		"module.vpc":          {"var.region"},
+		"output.web_id":       {"aws_instance.web"},
+		"output.vpc_id":       {"module.vpc"},
+		"var.region":          {},
+	}
+	for addr, expected := range from {
+		got := []string{}
+		for _, ref := range g.From(addr) {
+			// Type constraints such as string are traversals too
+			if ref.Addr() != "" {
+				got = append(got, ref.Addr())
+			}
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("From(%q) = %v, want %v", addr, got, expected)
+		}
+	}
+}
+
+func TestBuild_JSON(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"main.tf.json": `{"output": {"region": {"value": "${var.region}"}}}`,
+	})
+
+	g, err := Build(runner)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	refs := g.To("var.region")
+	if len(refs) != 1 {
+		t.Fatalf("expected 1 reference to var.region, got %d", len(refs))
+	}
+	if refs[0].From != "" {
+		t.Errorf("expected references in JSON files to have no declaring block, got %q", refs[0].From)
+	}
+}
+
+func TestReference_Addr(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Expr     string
+		Expected string
+	}{
+		{Name: "variable", Expr: "var.region", Expected: "var.region"},
+		{Name: "resource attribute", Expr: "aws_vpc.main.id", Expected: "aws_vpc.main"},
+		{Name: "data source attribute", Expr: "data.aws_ami.ubuntu.id", Expected: "data.aws_ami.ubuntu"},
+		{Name: "indexed resource", Expr: "aws_instance.web[0].id", Expected: "aws_instance.web"},
+		{Name: "indexed data source", Expr: "data.aws_ami.ubuntu[0].id", Expected: "data.aws_ami.ubuntu"},
+		{Name: "single step", Expr: "string", Expected: ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			traversal, diags := hclsyntax.ParseTraversalAbs([]byte(tc.Expr), "", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatalf("Unexpected error occurred: %s", diags)
+			}
+			if got := (Reference{Traversal: traversal}).Addr(); got != tc.Expected {
+				t.Errorf("expected %q, got %q", tc.Expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Package ruletest runs rule tests from fixture directories.
+//
+// Each subdirectory of a rule's fixture directory, such as rules/testdata/<rule>/<case>/, is a test case.
+// It holds the .tf and .tf.json files of the module under test, an optional .tflint.hcl, any other files
+// and subdirectories the rule reads from the filesystem, and an expected.json listing the issues the rule emits:
+//
+//	[
+//	  {
+//	    "message": "variable \"region\" should have a description",
+//	    "range": {
+//	      "filename": "variables.tf",
+//	      "start": {"line": 1, "column": 1},
+//	      "end": {"line": 1, "column": 18}
+//	    }
+//	  }
+//	]
+//
+// Filenames in expected.json are relative to the case directory and the order of issues does not matter.
+// A case without issues uses [].
+package ruletest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// expectedFile is the name of the file listing the expected issues of a case
+const expectedFile = "expected.json"
+
+// Issue is an issue listed in expected.json
+type Issue struct {
+	Message string `json:"message"`
+	Range   Range  `json:"range"`
+}
+
+// Range is the range of an issue, with a filename relative to the case directory
+type Range struct {
+	Filename string `json:"filename"`
+	Start    Pos    `json:"start"`
+	End      Pos    `json:"end"`
+}
+
+// Pos is a position in a file
+type Pos struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// Run runs the rule against every case directory in dir as a subtest named after the directory
+func Run(t *testing.T, rule tflint.Rule, dir string) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := 0
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		cases++
+		caseDir := filepath.Join(dir, entry.Name())
+		t.Run(entry.Name(), func(t *testing.T) {
+			runCase(t, rule, caseDir)
+		})
+	}
+	if cases == 0 {
+		t.Fatalf("%s has no test cases", dir)
+	}
+}
+
+// runCase checks the issues the rule emits for the module in dir against its expected.json
+func runCase(t *testing.T, rule tflint.Rule, dir string) {
+	t.Helper()
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := loadFiles(abs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := loadExpected(abs, rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runner := helper.TestRunner(t, files)
+
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	sortIssues(expected)
+	sortIssues(runner.Issues)
+	helper.AssertIssues(t, expected, runner.Issues)
+}
+
+// sortIssues sorts issues by position, since the order of issues across files depends on map iteration
+func sortIssues(issues helper.Issues) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i].Range, issues[j].Range
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		if a.Start.Line != b.Start.Line {
+			return a.Start.Line < b.Start.Line
+		}
+		if a.Start.Column != b.Start.Column {
+			return a.Start.Column < b.Start.Column
+		}
+		return issues[i].Message < issues[j].Message
+	})
+}
+
+// loadFiles returns the contents of the Terraform files directly in dir keyed by absolute path, so that rules
+// reading other files or subdirectories next to them find the fixtures. The .tflint.hcl file is keyed by its name.
+func loadFiles(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !(strings.HasSuffix(name, ".tf") || strings.HasSuffix(name, ".tf.json") || name == ".tflint.hcl") {
+			continue
+		}
+
+		path := filepath.Join(dir, name)
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if name == ".tflint.hcl" {
+			files[name] = string(src)
+		} else {
+			files[path] = string(src)
+		}
+	}
+	return files, nil
+}
+
+// loadExpected reads the expected issues of the case in dir, attributed to the rule
+func loadExpected(dir string, rule tflint.Rule) (helper.Issues, error) {
+	src, err := os.ReadFile(filepath.Join(dir, expectedFile))
+	if err != nil {
+		return nil, err
+	}
+	var issues []Issue
+	if err := json.Unmarshal(src, &issues); err != nil {
+		return nil, err
+	}
+
+	expected := make(helper.Issues, 0, len(issues))
+	for _, issue := range issues {
+		expected = append(expected, &helper.Issue{
+			Rule:    rule,
+			Message: issue.Message,
+			Range: hcl.Range{
+				Filename: filepath.Join(dir, filepath.FromSlash(issue.Range.Filename)),
+				Start:    hcl.Pos{Line: issue.Range.Start.Line, Column: issue.Range.Start.Column},
+				End:      hcl.Pos{Line: issue.Range.End.Line, Column: issue.Range.End.Column},
+			},
+		})
+	}
+	return expected, nil
+}
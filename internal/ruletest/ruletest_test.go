@@ -0,0 +1,37 @@
+package ruletest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// resourceRule emits an issue for every resource of the module
+type resourceRule struct {
+	tflint.DefaultRule
+}
+
+func (r *resourceRule) Name() string              { return "resource_rule" }
+func (r *resourceRule) Enabled() bool             { return true }
+func (r *resourceRule) Severity() tflint.Severity { return tflint.NOTICE }
+
+func (r *resourceRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: "resource", LabelNames: []string{"type", "name"}}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	for _, resource := range body.Blocks {
+		if err := runner.EmitIssue(r, fmt.Sprintf("resource %q found", resource.Labels[1]), resource.DefRange); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Test_Run(t *testing.T) {
+	Run(t, &resourceRule{}, "testdata/resource_rule")
+}
@@ -0,0 +1,151 @@
+package ruletest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// blocksPerFile is the number of blocks of one kind written to each file of a synthetic module
+const blocksPerFile = 100
+
+// SyntheticModule returns the files of a module with size variables, locals, resources, data sources, module calls
+// and outputs, for benchmarking rules. Blocks reference each other the way real modules do, so rules walking
+// expressions and references see a realistic graph. The output is deterministic.
+func SyntheticModule(size int) map[string]string {
+	files := map[string]string{
+		"versions.tf": `
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+  }
+}
+`,
+		"common.tf": `
+variable "environment" {
+  type        = string
+  description = "Environment the module is deployed to."
+}
+
+variable "tags" {
+  type        = map(string)
+  description = "Tags applied to every resource."
+  default     = {}
+}
+
+variable "volumes" {
+  type        = list(string)
+  description = "Device names of the extra volumes."
+  default     = []
+}
+`,
+	}
+
+	kinds := []struct {
+		prefix string
+		block  func(i int) string
+	}{
+		{"variables", syntheticVariable},
+		{"locals", syntheticLocal},
+		{"main", syntheticResource},
+		{"data", syntheticDataSource},
+		{"modules", syntheticModuleCall},
+		{"outputs", syntheticOutput},
+	}
+	for _, kind := range kinds {
+		for start := 0; start < size; start += blocksPerFile {
+			var src strings.Builder
+			for i := start; i < size && i < start+blocksPerFile; i++ {
+				src.WriteString(kind.block(i))
+			}
+			files[fmt.Sprintf("%s_%03d.tf", kind.prefix, start/blocksPerFile)] = src.String()
+		}
+	}
+
+	return files
+}
+
+func syntheticVariable(i int) string {
+	return fmt.Sprintf(`
+variable "name_%[1]d" {
+  type        = string
+  description = "Name of application %[1]d."
+  default     = "app-%[1]d"
+
+  validation {
+    condition     = length(var.name_%[1]d) > 0
+    error_message = "The name must not be empty."
+  }
+}
+`, i)
+}
+
+func syntheticLocal(i int) string {
+	return fmt.Sprintf(`
+locals {
+  prefix_%[1]d = "${var.name_%[1]d}-${var.environment}"
+}
+`, i)
+}
+
+func syntheticResource(i int) string {
+	return fmt.Sprintf(`
+resource "aws_instance" "app_%[1]d" {
+  count         = var.environment == "prod" ? 2 : 1
+  ami           = data.aws_ami.app_%[1]d.id
+  instance_type = "t3.micro"
+
+  tags = merge(var.tags, {
+    Name = local.prefix_%[1]d
+  })
+
+  dynamic "ebs_block_device" {
+    for_each = var.volumes
+    content {
+      device_name = ebs_block_device.value
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [ami]
+  }
+}
+`, i)
+}
+
+func syntheticDataSource(i int) string {
+	return fmt.Sprintf(`
+data "aws_ami" "app_%[1]d" {
+  most_recent = true
+  owners      = ["self"]
+
+  filter {
+    name   = "name"
+    values = ["app-%[1]d-*"]
+  }
+}
+`, i)
+}
+
+func syntheticModuleCall(i int) string {
+	return fmt.Sprintf(`
+module "dns_%[1]d" {
+  source  = "./modules/dns"
+  name    = local.prefix_%[1]d
+  targets = aws_instance.app_%[1]d[*].private_ip
+}
+`, i)
+}
+
+func syntheticOutput(i int) string {
+	return fmt.Sprintf(`
+output "app_%[1]d_ids" {
+  description = "IDs of the instances of application %[1]d."
+  value       = aws_instance.app_%[1]d[*].id
+}
+`, i)
+}
@@ -0,0 +1,42 @@
+package ruletest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func Test_SyntheticModule(t *testing.T) {
+	files := SyntheticModule(250)
+
+	parser := hclparse.NewParser()
+	counts := map[string]int{}
+	for name, src := range files {
+		file, diags := parser.ParseHCL([]byte(src), name)
+		if diags.HasErrors() {
+			t.Fatalf("%s: %s", name, diags)
+		}
+		for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+			counts[block.Type]++
+		}
+	}
+
+	expected := map[string]int{
+		"terraform": 1,
+		"variable":  253,
+		"locals":    250,
+		"resource":  250,
+		"data":      250,
+		"module":    250,
+		"output":    250,
+	}
+	for kind, count := range expected {
+		if counts[kind] != count {
+			t.Errorf("expected %d %s blocks, got %d", count, kind, counts[kind])
+		}
+	}
+	if len(files) != 20 {
+		t.Errorf("expected 20 files, got %d", len(files))
+	}
+}
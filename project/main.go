@@ -0,0 +1,12 @@
+package project
+
+import "fmt"
+
+// Version is the ruleset version. Release builds set it with
+// -ldflags "-X github.com/jforde/tflint-ruleset-hackathon/project.Version=x.y.z".
+var Version = "0.1.0"
+
+// ReferenceLink returns the rule reference link
+func ReferenceLink(name string) string {
+	return fmt.Sprintf("https://github.com/jforde/tflint-ruleset-hackathon/blob/v%s/docs/rules/%s.md", Version, name)
+}
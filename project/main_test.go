@@ -0,0 +1,10 @@
+package project
+
+import "testing"
+
+func Test_ReferenceLink(t *testing.T) {
+	want := "https://github.com/jforde/tflint-ruleset-hackathon/blob/v" + Version + "/docs/rules/standard_module_structure.md"
+	if got := ReferenceLink("standard_module_structure"); got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/jforde/tflint-ruleset-hackathon/internal/ruletest"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const (
+	// budgetModuleSize is the number of blocks of each kind in the module checked against checkBudget
+	budgetModuleSize = 500
+	// checkBudget is the time a single rule may spend checking a module of budgetModuleSize
+	checkBudget = 2 * time.Second
+)
+
+// benchmarkRunner returns a test runner for the files. helper.TestRunner only accepts a *testing.T, which it
+// uses to report parse errors, so the files are parsed here first and a bad fixture fails the benchmark instead.
+func benchmarkRunner(b *testing.B, files map[string]string) *helper.Runner {
+	b.Helper()
+
+	parser := hclparse.NewParser()
+	for name, src := range files {
+		if strings.HasSuffix(name, ".json") {
+			continue
+		}
+		if _, diags := parser.ParseHCL([]byte(src), name); diags.HasErrors() {
+			b.Fatal(diags)
+		}
+	}
+	return helper.TestRunner(new(testing.T), files)
+}
+
+// checkRule runs the rule the way tflint does, through the runner of the ruleset so that caches are shared
+// between the requests of a single run
+func checkRule(rule tflint.Rule, runner *helper.Runner) error {
+	ruleset := &RuleSet{}
+	wrapped, err := ruleset.NewRunner(runner)
+	if err != nil {
+		return err
+	}
+	return rule.Check(wrapped)
+}
+
+func BenchmarkRules(b *testing.B) {
+	for _, size := range []int{100, 1000} {
+		runner := benchmarkRunner(b, ruletest.SyntheticModule(size))
+
+		for _, rule := range Rules {
+			b.Run(fmt.Sprintf("%s/size=%d", rule.Name(), size), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					runner.Issues = helper.Issues{}
+					if err := checkRule(rule, runner); err != nil {
+						b.Fatalf("Unexpected error occurred: %s", err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func Test_CheckBudget(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping performance budget in short mode")
+	}
+
+	runner := helper.TestRunner(t, ruletest.SyntheticModule(budgetModuleSize))
+
+	for _, rule := range Rules {
+		t.Run(rule.Name(), func(t *testing.T) {
+			runner.Issues = helper.Issues{}
+			start := time.Now()
+			if err := checkRule(rule, runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if elapsed := time.Since(start); elapsed > checkBudget {
+				t.Errorf("checking %d blocks of each kind took %s, over the budget of %s", budgetModuleSize, elapsed, checkBudget)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package rules
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// blankLinesRuleConfig is the config structure for the blank_lines rule
+type blankLinesRuleConfig struct {
+	MaxBlankLines int `hclext:"max_blank_lines,optional"`
+}
+
+// BlankLinesRule checks whether files have runs of blank lines or lack a trailing newline
+type BlankLinesRule struct {
+	tflint.DefaultRule
+}
+
+// NewBlankLinesRule returns a new rule
+func NewBlankLinesRule() *BlankLinesRule {
+	return &BlankLinesRule{}
+}
+
+// Name returns the rule name
+func (r *BlankLinesRule) Name() string {
+	return "blank_lines"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *BlankLinesRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *BlankLinesRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *BlankLinesRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *BlankLinesRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow consecutive blank lines and files without a trailing newline",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "max_blank_lines", Description: "Maximum number of consecutive blank lines", Default: "1"},
+		},
+		Example: `
+variable "region" {
+  type = string
+}
+
+
+variable "zone" {
+  type = string
+}`,
+	}
+}
+
+// Check emits an issue for the blank lines beyond max_blank_lines in every run of blank lines, for blank lines
+// at the end of files and for files that do not end with a newline. Blank lines within heredocs and multi-line strings are part of the value
+// and left alone. Both are fixed by removing the extra lines or appending the newline.
+func (r *BlankLinesRule) Check(runner tflint.Runner) error {
+	config := &blankLinesRuleConfig{MaxBlankLines: 1}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if filepath.Ext(name) == ".json" {
+			// JSON files are likely generated
+			continue
+		}
+		src := files[name].Bytes
+		if len(src) == 0 {
+			continue
+		}
+
+		// starts holds the byte offset of every line, plus the end of the file
+		starts := []int{0}
+		for i, c := range src {
+			if c == '\n' && i+1 < len(src) {
+				starts = append(starts, i+1)
+			}
+		}
+		starts = append(starts, len(src))
+		lines := len(starts) - 1
+		pos := func(line int) hcl.Pos {
+			return hcl.Pos{Line: line, Column: 1, Byte: starts[line-1]}
+		}
+
+		blank := blankLines(src, name, lines)
+		for line := 1; line <= lines; {
+			if !blank[line] {
+				line++
+				continue
+			}
+			end := line
+			for end <= lines && blank[end] {
+				end++
+			}
+			count := end - line
+			allowed, message := config.MaxBlankLines, fmt.Sprintf("%d consecutive blank lines (max %d)", count, config.MaxBlankLines)
+			if end > lines {
+				allowed, message = 0, fmt.Sprintf("File should not end with blank lines, found %d", count)
+			}
+			if count > allowed {
+				excess := hcl.Range{Filename: name, Start: pos(line + allowed)}
+				if end > lines {
+					excess.End = hcl.Pos{Line: lines, Column: starts[lines] - starts[lines-1] + 1, Byte: starts[lines]}
+				} else {
+					excess.End = pos(end)
+				}
+				if err := runner.EmitIssueWithFix(
+					r,
+					message,
+					excess,
+					func(f tflint.Fixer) error {
+						return f.Remove(excess)
+					},
+				); err != nil {
+					return err
+				}
+			}
+			line = end
+		}
+
+		if src[len(src)-1] != '\n' {
+			eof := hcl.Pos{Line: lines, Column: len(src) - starts[lines-1] + 1, Byte: len(src)}
+			rng := hcl.Range{Filename: name, Start: eof, End: eof}
+			if err := runner.EmitIssueWithFix(
+				r,
+				"File should end with a newline",
+				rng,
+				func(f tflint.Fixer) error {
+					return f.InsertTextAfter(rng, "\n")
+				},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// blankLines returns the 1-based numbers of the lines that hold nothing but whitespace, leaving out lines
+// within tokens that span several lines such as heredocs
+func blankLines(src []byte, filename string, lines int) map[int]bool {
+	covered := map[int]bool{}
+	tokens, _ := hclsyntax.LexConfig(src, filename, hcl.InitialPos)
+	for _, token := range tokens {
+		if token.Type == hclsyntax.TokenNewline || token.Type == hclsyntax.TokenEOF {
+			continue
+		}
+		end := token.Range.End.Line
+		if end > token.Range.Start.Line && token.Range.End.Column == 1 {
+			// Comments include the newline ending them
+			end--
+		}
+		for line := token.Range.Start.Line; line <= end; line++ {
+			covered[line] = true
+		}
+	}
+
+	blank := map[int]bool{}
+	for i, line := range bytes.Split(src, []byte("\n")) {
+		if i+1 <= lines && !covered[i+1] && len(bytes.TrimSpace(line)) == 0 {
+			blank[i+1] = true
+		}
+	}
+	return blank
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_BlankLinesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Fixed    map[string]string
+	}{
+		{
+			Name: "single blank lines",
+			Content: map[string]string{
+				"main.tf":      "variable \"region\" {}\n\nvariable \"zone\" {}\n",
+				"main.tf.json": `{"variable": {"name": {}}}`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "heredoc",
+			Content: map[string]string{
+				"main.tf": "locals {\n  script = <<-EOT\n    set -e\n\n\n    make\n  EOT\n}\n",
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "consecutive blank lines",
+			Content: map[string]string{
+				"main.tf": "variable \"region\" {}\n\n\n\nvariable \"zone\" {}\n  \n\t\n# comment\n",
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewBlankLinesRule(),
+					Message: "3 consecutive blank lines (max 1)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 5, Column: 1},
+					},
+				},
+				{
+					Rule:    NewBlankLinesRule(),
+					Message: "2 consecutive blank lines (max 1)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 1},
+						End:      hcl.Pos{Line: 8, Column: 1},
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"main.tf": "variable \"region\" {}\n\nvariable \"zone\" {}\n\n# comment\n",
+			},
+		},
+		{
+			Name: "end of file",
+			Content: map[string]string{
+				"main.tf":    "variable \"region\" {}\n\n\n",
+				"outputs.tf": "output \"region\" {\n  value = var.region\n}",
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewBlankLinesRule(),
+					Message: "File should not end with blank lines, found 2",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 2},
+					},
+				},
+				{
+					Rule:    NewBlankLinesRule(),
+					Message: "File should end with a newline",
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 3, Column: 2},
+						End:      hcl.Pos{Line: 3, Column: 2},
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"main.tf":    "variable \"region\" {}\n",
+				"outputs.tf": "output \"region\" {\n  value = var.region\n}\n",
+			},
+		},
+	}
+
+	rule := NewBlankLinesRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			if tc.Fixed != nil {
+				helper.AssertChanges(t, tc.Fixed, runner.Changes())
+			}
+		})
+	}
+}
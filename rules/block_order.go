@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// bodyItem is an argument or nested block in a body
+type bodyItem struct {
+	name  string
+	rng   hcl.Range
+	start int
+}
+
+// bodyItems returns the arguments and nested blocks of a body in source order
+func bodyItems(body *hclsyntax.Body) []bodyItem {
+	items := []bodyItem{}
+	for _, attr := range body.Attributes {
+		items = append(items, bodyItem{name: attr.Name, rng: attr.SrcRange, start: attr.SrcRange.Start.Byte})
+	}
+	for _, nested := range body.Blocks {
+		items = append(items, bodyItem{name: nested.Type, rng: nested.DefRange(), start: nested.TypeRange.Start.Byte})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].start < items[j].start
+	})
+	return items
+}
+
+// blockOrder is the actual and expected order of the blocks in a file
+type blockOrder struct {
+	actual   hclext.Blocks
+	expected hclext.Blocks
+}
+
+// newBlockOrder returns the order of the blocks declared in the given file, expected to be sorted by less
+func newBlockOrder(blocks hclext.Blocks, filename string, less func(a, b *hclext.Block) bool) *blockOrder {
+	actual := hclext.Blocks{}
+	for _, block := range blocks {
+		if filepath.Base(block.DefRange.Filename) == filename {
+			actual = append(actual, block)
+		}
+	}
+	sort.SliceStable(actual, func(i, j int) bool {
+		return actual[i].DefRange.Start.Byte < actual[j].DefRange.Start.Byte
+	})
+
+	expected := make(hclext.Blocks, len(actual))
+	copy(expected, actual)
+	sort.SliceStable(expected, func(i, j int) bool {
+		return less(expected[i], expected[j])
+	})
+
+	return &blockOrder{actual: actual, expected: expected}
+}
+
+// firstOutOfOrder returns the first block that is not at its expected position, along with
+// that position counted from 1. It returns nil if the blocks are in order.
+func (o *blockOrder) firstOutOfOrder() (*hclext.Block, int) {
+	for i, block := range o.actual {
+		if block == o.expected[i] {
+			continue
+		}
+		for j := range o.expected {
+			if o.expected[j] == block {
+				return block, j + 1
+			}
+		}
+	}
+	return nil, 0
+}
+
+// fix returns a fix that rewrites each misplaced block with the block expected at its position.
+// Comments between blocks stay where they are.
+func (o *blockOrder) fix(runner tflint.Runner) func(tflint.Fixer) error {
+	return func(f tflint.Fixer) error {
+		for i, block := range o.actual {
+			if block == o.expected[i] {
+				continue
+			}
+
+			rng, err := blockRange(runner, block.DefRange)
+			if err != nil {
+				return err
+			}
+			replacement, err := blockRange(runner, o.expected[i].DefRange)
+			if err != nil {
+				return err
+			}
+			if err := f.ReplaceText(rng, string(f.TextAt(replacement).Bytes)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
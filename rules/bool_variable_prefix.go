@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// boolVariablePrefixRuleConfig is the config structure for the bool_variable_prefix rule
+type boolVariablePrefixRuleConfig struct {
+	Prefixes []string `hclext:"prefixes,optional"`
+}
+
+// BoolVariablePrefixRule checks whether bool variables are named with one of the configured prefixes
+type BoolVariablePrefixRule struct {
+	tflint.DefaultRule
+}
+
+// NewBoolVariablePrefixRule returns a new rule
+func NewBoolVariablePrefixRule() *BoolVariablePrefixRule {
+	return &BoolVariablePrefixRule{}
+}
+
+// Name returns the rule name
+func (r *BoolVariablePrefixRule) Name() string {
+	return "bool_variable_prefix"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *BoolVariablePrefixRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *BoolVariablePrefixRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *BoolVariablePrefixRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *BoolVariablePrefixRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require bool variables to be named with a prefix such as enable_ or is_",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "prefixes", Description: "Prefixes bool variable names must start with. A name equal to a prefix without its trailing underscore, such as create, is accepted too", Default: `["enable_", "is_", "create_"]`},
+		},
+		Example: `
+variable "public" {
+  type = bool
+}
+`,
+	}
+}
+
+// Check emits issues for variables of type bool whose names do not start with any of the prefixes
+func (r *BoolVariablePrefixRule) Check(runner tflint.Runner) error {
+	config := &boolVariablePrefixRuleConfig{
+		Prefixes: []string{"enable_", "is_", "create_"},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if len(config.Prefixes) == 0 {
+		return nil
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "type"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		attr, exists := variable.Body.Attributes["type"]
+		if !exists {
+			continue
+		}
+		ty, _, diags := typeexpr.TypeConstraintWithDefaults(attr.Expr)
+		if diags.HasErrors() || ty != cty.Bool {
+			continue
+		}
+
+		name := variable.Labels[0]
+		if hasBoolPrefix(name, config.Prefixes) {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("bool variable %q should start with one of: %s", name, strings.Join(config.Prefixes, ", ")),
+			variable.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasBoolPrefix returns whether the name starts with one of the prefixes, or is a prefix without its trailing underscore
+func hasBoolPrefix(name string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) || name == strings.TrimSuffix(prefix, "_") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_BoolVariablePrefixRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "prefixed names",
+			Content: map[string]string{
+				"main.tf": `
+variable "enable_logging" {
+  type = bool
+}
+variable "is_public" {
+  type = bool
+}
+variable "create" {
+  type = bool
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "other types",
+			Content: map[string]string{
+				"main.tf": `
+variable "logging" {
+  type = string
+}
+variable "public" {}
+variable "flags" {
+  type = list(bool)
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "ambiguous name",
+			Content: map[string]string{
+				"main.tf": `
+variable "logging" {
+  type = bool
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewBoolVariablePrefixRule(),
+					Message: `bool variable "logging" should start with one of: enable_, is_, create_`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom prefixes",
+			Content: map[string]string{
+				"main.tf": `
+variable "enable_logging" {
+  type = bool
+}
+variable "has_logging" {
+  type = bool
+}
+`,
+				".tflint.hcl": `
+rule "bool_variable_prefix" {
+  enabled  = true
+  prefixes = ["has_"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewBoolVariablePrefixRule(),
+					Message: `bool variable "enable_logging" should start with one of: has_`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 26},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewBoolVariablePrefixRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package rules
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/internal/refs"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/addrs"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// cachingRunner is a runner that memoizes the results of file and module content requests.
+// TFLint creates a runner for each module inspected and then runs every enabled rule against it sequentially,
+// so the files and the content requested by one rule are served from memory to the rules that follow.
+// Each request otherwise makes a round trip to the TFLint process. Cached results are shared, so rules must not modify them.
+// With --fix, TFLint applies the fixes of a rule once the rule returns, so the cache is cleared after fixes are applied.
+type cachingRunner struct {
+	tflint.Runner
+
+	files      map[string]*hcl.File
+	modulePath addrs.Module
+	pathCached bool
+	contents   map[string]*hclext.BodyContent
+	references *refs.Graph
+	testFiles  map[string]*hcl.File
+
+	// fixer holds the fixes emitted through the runner until TFLint applies them
+	fixer        changeTracker
+	fixesPending bool
+}
+
+// changeTracker is implemented by the fixer of the SDK, which reports changes until they are applied
+type changeTracker interface {
+	HasChanges() bool
+}
+
+// newCachingRunner returns a runner that caches the requests made to the runner
+func newCachingRunner(runner tflint.Runner) *cachingRunner {
+	return &cachingRunner{
+		Runner:   runner,
+		contents: map[string]*hclext.BodyContent{},
+	}
+}
+
+// EmitIssueWithFix emits the issue and records whether its fix is pending, so the cache is cleared once it is applied
+func (r *cachingRunner) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fixFunc func(f tflint.Fixer) error) error {
+	var fixer tflint.Fixer
+	err := r.Runner.EmitIssueWithFix(rule, message, issueRange, func(f tflint.Fixer) error {
+		fixer = f
+		return fixFunc(f)
+	})
+	if fixer == nil {
+		// Fixes are only made in the root module
+		return err
+	}
+
+	if tracker, ok := fixer.(changeTracker); ok {
+		r.fixer = tracker
+		r.fixesPending = r.fixesPending || tracker.HasChanges()
+	} else {
+		// Without a way to tell when the fix is applied, nothing is cached from now on
+		r.fixer = nil
+		r.fixesPending = true
+	}
+	return err
+}
+
+// refresh clears the cache when pending fixes have been applied since the results were requested
+func (r *cachingRunner) refresh() {
+	if !r.fixesPending || (r.fixer != nil && r.fixer.HasChanges()) {
+		return
+	}
+	r.files = nil
+	r.contents = map[string]*hclext.BodyContent{}
+	r.references = nil
+	r.testFiles = nil
+	r.fixesPending = r.fixer == nil
+}
+
+// GetFiles returns the files of the module, requesting them only once
+func (r *cachingRunner) GetFiles() (map[string]*hcl.File, error) {
+	r.refresh()
+	if r.files != nil {
+		return r.files, nil
+	}
+	files, err := r.Runner.GetFiles()
+	if err != nil {
+		return nil, err
+	}
+	r.files = files
+	return files, nil
+}
+
+// GetFile returns the file from the module files when it is one of them.
+// Other files such as .tflint.hcl are requested from the runner.
+func (r *cachingRunner) GetFile(filename string) (*hcl.File, error) {
+	files, err := r.GetFiles()
+	if err != nil {
+		return nil, err
+	}
+	if file, exists := files[filename]; exists {
+		return file, nil
+	}
+	return r.Runner.GetFile(filename)
+}
+
+// GetModulePath returns the path of the module, requesting it only once
+func (r *cachingRunner) GetModulePath() (addrs.Module, error) {
+	if r.pathCached {
+		return r.modulePath, nil
+	}
+	path, err := r.Runner.GetModulePath()
+	if err != nil {
+		return nil, err
+	}
+	r.modulePath = path
+	r.pathCached = true
+	return path, nil
+}
+
+// GetModuleContent returns the module content, requesting it only once for each schema and option
+func (r *cachingRunner) GetModuleContent(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	key, err := json.Marshal(struct {
+		Schema *hclext.BodySchema
+		Option *tflint.GetModuleContentOption
+	}{schema, opts})
+	r.refresh()
+	if err != nil {
+		// Requests that cannot be keyed are not cached
+		return r.Runner.GetModuleContent(schema, opts)
+	}
+	if content, exists := r.contents[string(key)]; exists {
+		return content, nil
+	}
+
+	content, err := r.Runner.GetModuleContent(schema, opts)
+	if err != nil {
+		return nil, err
+	}
+	r.contents[string(key)] = content
+	return content, nil
+}
+
+// References returns the reference graph of the module, building it only once
+func (r *cachingRunner) References() (*refs.Graph, error) {
+	r.refresh()
+	if r.references != nil {
+		return r.references, nil
+	}
+	graph, err := refs.Build(r)
+	if err != nil {
+		return nil, err
+	}
+	r.references = graph
+	return graph, nil
+}
+
+// TestFiles returns the parsed test files of the module, reading them only once
+func (r *cachingRunner) TestFiles() (map[string]*hcl.File, error) {
+	r.refresh()
+	if r.testFiles != nil {
+		return r.testFiles, nil
+	}
+	files, err := parseTestFiles(r)
+	if err != nil {
+		return nil, err
+	}
+	r.testFiles = files
+	return files, nil
+}
@@ -0,0 +1,162 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// countingRunner is a runner that counts the requests that reach it
+type countingRunner struct {
+	tflint.Runner
+	calls map[string]int
+}
+
+func (r *countingRunner) GetFiles() (map[string]*hcl.File, error) {
+	r.calls["GetFiles"]++
+	return r.Runner.GetFiles()
+}
+
+func (r *countingRunner) GetFile(filename string) (*hcl.File, error) {
+	r.calls["GetFile"]++
+	return r.Runner.GetFile(filename)
+}
+
+func (r *countingRunner) WalkExpressions(walker tflint.ExprWalker) hcl.Diagnostics {
+	r.calls["WalkExpressions"]++
+	return r.Runner.WalkExpressions(walker)
+}
+
+func (r *countingRunner) GetModuleContent(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	r.calls["GetModuleContent"]++
+	return r.Runner.GetModuleContent(schema, opts)
+}
+
+func Test_CachingRunner(t *testing.T) {
+	counter := &countingRunner{
+		Runner: helper.TestRunner(t, map[string]string{
+			"main.tf": `
+variable "region" {}
+output "region" {
+  value = var.region
+}
+`,
+		}),
+		calls: map[string]int{},
+	}
+	runner := newCachingRunner(counter)
+
+	variables := &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: "variable", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}}},
+	}
+	outputs := &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: "output", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}}},
+	}
+	opts := &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone}
+
+	for i := 0; i < 3; i++ {
+		if _, err := runner.GetFiles(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := runner.GetFile("main.tf"); err != nil {
+			t.Fatal(err)
+		}
+		for _, schema := range []*hclext.BodySchema{variables, outputs} {
+			content, err := runner.GetModuleContent(schema, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(content.Blocks) != 1 || content.Blocks[0].Type != schema.Blocks[0].Type {
+				t.Fatalf("unexpected content for %s: %#v", schema.Blocks[0].Type, content.Blocks)
+			}
+		}
+		graph, err := runner.References()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !graph.Referenced("var.region") {
+			t.Fatalf("expected var.region to be referenced")
+		}
+	}
+
+	want := map[string]int{"GetFiles": 1, "GetModuleContent": 2, "WalkExpressions": 1}
+	for name, count := range want {
+		if counter.calls[name] != count {
+			t.Errorf("%s: want %d calls, got %d", name, count, counter.calls[name])
+		}
+	}
+	if counter.calls["GetFile"] != 0 {
+		t.Errorf("GetFile: want module files to be served from GetFiles, got %d calls", counter.calls["GetFile"])
+	}
+}
+
+// applyingRunner is a runner that applies the fixes of a rule when it returns, as TFLint does with --fix
+type applyingRunner struct {
+	*helper.Runner
+	t       *testing.T
+	sources map[string]string
+	pending bool
+}
+
+func (r *applyingRunner) EmitIssueWithFix(rule tflint.Rule, message string, location hcl.Range, fixFunc func(f tflint.Fixer) error) error {
+	err := r.Runner.EmitIssueWithFix(rule, message, location, func(f tflint.Fixer) error {
+		return fixFunc(&trackingFixer{Fixer: f, runner: r})
+	})
+	r.pending = len(r.Runner.Changes()) > 0
+	return err
+}
+
+// applyChanges writes the fixes to the sources and parses them again
+func (r *applyingRunner) applyChanges() {
+	for name, src := range r.Runner.Changes() {
+		r.sources[name] = string(src)
+	}
+	r.Runner = helper.TestRunner(r.t, r.sources)
+	r.pending = false
+}
+
+// trackingFixer reports the pending fixes of the applyingRunner, like the fixer of the SDK
+type trackingFixer struct {
+	tflint.Fixer
+	runner *applyingRunner
+}
+
+func (f *trackingFixer) HasChanges() bool {
+	return f.runner.pending
+}
+
+func Test_CachingRunner_Fixes(t *testing.T) {
+	sources := map[string]string{
+		"main.tf": `// Region of the provider
+variable "region" {}
+
+
+output "region" {
+  value = var.region
+}
+`,
+	}
+	applier := &applyingRunner{Runner: helper.TestRunner(t, sources), t: t, sources: sources}
+	runner := newCachingRunner(applier)
+
+	for _, rule := range []tflint.Rule{NewCommentStyleRule(), NewBlankLinesRule()} {
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("%s: unexpected error occurred: %s", rule.Name(), err)
+		}
+		applier.applyChanges()
+	}
+
+	want := `# Region of the provider
+variable "region" {}
+
+output "region" {
+  value = var.region
+}
+`
+	if got := applier.sources["main.tf"]; got != want {
+		t.Errorf("unexpected fixes:\n%s", got)
+	}
+}
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"bytes"
+	"path/filepath"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// CommentStyleRule checks whether single-line comments use # instead of //
+type CommentStyleRule struct {
+	tflint.DefaultRule
+}
+
+// NewCommentStyleRule returns a new rule
+func NewCommentStyleRule() *CommentStyleRule {
+	return &CommentStyleRule{}
+}
+
+// Name returns the rule name
+func (r *CommentStyleRule) Name() string {
+	return "comment_style"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *CommentStyleRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *CommentStyleRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *CommentStyleRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *CommentStyleRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow // comments in favor of #",
+		Tags:        []string{TagStyle},
+		Example: `
+// The main bucket
+resource "aws_s3_bucket" "main" {}
+`,
+	}
+}
+
+// Check emits an issue for every // comment, with a fix that replaces the // with #
+func (r *CommentStyleRule) Check(runner tflint.Runner) error {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if filepath.Ext(name) == ".json" {
+			// JSON files have no comments
+			continue
+		}
+
+		tokens, diags := hclsyntax.LexConfig(files[name].Bytes, name, hcl.InitialPos)
+		if diags.HasErrors() {
+			return diags
+		}
+
+		for _, token := range tokens {
+			if token.Type != hclsyntax.TokenComment || !bytes.HasPrefix(token.Bytes, []byte("//")) {
+				continue
+			}
+
+			start := token.Range.Start
+			slashes := hcl.Range{
+				Filename: name,
+				Start:    start,
+				End:      hcl.Pos{Line: start.Line, Column: start.Column + 2, Byte: start.Byte + 2},
+			}
+
+			if err := runner.EmitIssueWithFix(
+				r,
+				"Single-line comments should use # instead of //",
+				commentRange(token),
+				func(f tflint.Fixer) error {
+					return f.ReplaceText(slashes, "#")
+				},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// commentRange returns the range of a comment token without the trailing newline that line comments include
+func commentRange(token hclsyntax.Token) hcl.Range {
+	if bytes.HasPrefix(token.Bytes, []byte("/*")) {
+		return token.Range
+	}
+	text := bytes.TrimRight(token.Bytes, "\r\n")
+	start := token.Range.Start
+	return hcl.Range{
+		Filename: token.Range.Filename,
+		Start:    start,
+		End:      hcl.Pos{Line: start.Line, Column: start.Column + utf8.RuneCount(text), Byte: start.Byte + len(text)},
+	}
+}
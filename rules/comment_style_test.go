@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_CommentStyleRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Fixed    map[string]string
+	}{
+		{
+			Name: "hash comments",
+			Content: map[string]string{
+				"main.tf": `
+# The main bucket
+resource "aws_s3_bucket" "main" {
+  bucket = "main" # named after the module
+  /* block comments are fine */
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "double slash comments",
+			Content: map[string]string{
+				"main.tf": `
+// The main bucket
+resource "aws_s3_bucket" "main" {
+  bucket = "main" //named after the module
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewCommentStyleRule(),
+					Message: "Single-line comments should use # instead of //",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 19},
+					},
+				},
+				{
+					Rule:    NewCommentStyleRule(),
+					Message: "Single-line comments should use # instead of //",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 19},
+						End:      hcl.Pos{Line: 4, Column: 43},
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"main.tf": `
+# The main bucket
+resource "aws_s3_bucket" "main" {
+  bucket = "main" #named after the module
+}
+`,
+			},
+		},
+		{
+			Name: "JSON",
+			Content: map[string]string{
+				"main.tf.json": `{"resource": {"aws_s3_bucket": {"main": {"bucket": "//main"}}}}`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewCommentStyleRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			if tc.Fixed != nil {
+				helper.AssertChanges(t, tc.Fixed, runner.Changes())
+			}
+		})
+	}
+}
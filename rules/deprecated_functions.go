@@ -0,0 +1,172 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// deprecatedFunctions maps the functions removed from the language to the syntax that replaces them
+var deprecatedFunctions = map[string]string{
+	"list": "a tuple [ ... ] or tolist()",
+	"map":  "an object { ... } or tomap()",
+}
+
+// DeprecatedFunctionsRule checks whether expressions call functions removed from the language
+type DeprecatedFunctionsRule struct {
+	tflint.DefaultRule
+}
+
+// NewDeprecatedFunctionsRule returns a new rule
+func NewDeprecatedFunctionsRule() *DeprecatedFunctionsRule {
+	return &DeprecatedFunctionsRule{}
+}
+
+// Name returns the rule name
+func (r *DeprecatedFunctionsRule) Name() string {
+	return "deprecated_functions"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *DeprecatedFunctionsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *DeprecatedFunctionsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *DeprecatedFunctionsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *DeprecatedFunctionsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow the list() and map() functions removed in Terraform v0.15",
+		Tags:        []string{TagCorrectness},
+		Example: `
+locals {
+  zones = list("a", "b")
+}
+`,
+	}
+}
+
+// Check emits an issue for every call to a deprecated function, with a fix that rewrites it as a literal.
+// Calls with an expanded argument, or nested in another reported call, are reported without a fix.
+// Variable type constraints such as list(string) are not function calls and are skipped.
+func (r *DeprecatedFunctionsRule) Check(runner tflint.Runner) error {
+	typeConstraints, err := variableTypeRanges(runner)
+	if err != nil {
+		return err
+	}
+
+	reported := []hcl.Range{}
+	diags := runner.WalkExpressions(tflint.ExprWalkFunc(func(expr hcl.Expression) hcl.Diagnostics {
+		call, ok := expr.(*hclsyntax.FunctionCallExpr)
+		if !ok {
+			return nil
+		}
+		replacement, deprecated := deprecatedFunctions[call.Name]
+		if !deprecated {
+			return nil
+		}
+		for _, rng := range typeConstraints {
+			if rng.Filename == call.Range().Filename && rng.ContainsOffset(call.Range().Start.Byte) {
+				return nil
+			}
+		}
+
+		message := fmt.Sprintf("%s() was removed in Terraform v0.15, use %s instead", call.Name, replacement)
+		nested := false
+		for _, rng := range reported {
+			if rng.Filename == call.Range().Filename && rng.ContainsOffset(call.Range().Start.Byte) {
+				nested = true
+			}
+		}
+		reported = append(reported, call.Range())
+
+		var err error
+		if nested || call.ExpandFinal || (call.Name == "map" && len(call.Args)%2 != 0) {
+			err = runner.EmitIssue(r, message, call.Range())
+		} else {
+			err = runner.EmitIssueWithFix(r, message, call.Range(), func(f tflint.Fixer) error {
+				return f.ReplaceText(call.Range(), deprecatedCallLiteral(f, call))
+			})
+		}
+		if err != nil {
+			return hcl.Diagnostics{
+				{
+					Severity: hcl.DiagError,
+					Summary:  "failed to call EmitIssue()",
+					Detail:   err.Error(),
+				},
+			}
+		}
+		return nil
+	}))
+	if diags.HasErrors() {
+		return diags
+	}
+
+	return nil
+}
+
+// variableTypeRanges returns the ranges of the type constraints of the variables in the module
+func variableTypeRanges(runner tflint.Runner) ([]hcl.Range, error) {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "type"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return nil, err
+	}
+
+	ranges := []hcl.Range{}
+	for _, variable := range content.Blocks {
+		if attr, exists := variable.Body.Attributes["type"]; exists {
+			ranges = append(ranges, attr.Expr.Range())
+		}
+	}
+	return ranges, nil
+}
+
+// deprecatedCallLiteral returns the tuple or object literal equivalent to a list() or map() call.
+// Object keys other than string literals are wrapped in parentheses so they are evaluated as expressions.
+func deprecatedCallLiteral(f tflint.Fixer, call *hclsyntax.FunctionCallExpr) string {
+	if call.Name == "list" {
+		elems := make([]string, 0, len(call.Args))
+		for _, arg := range call.Args {
+			elems = append(elems, string(f.TextAt(arg.Range()).Bytes))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	}
+
+	if len(call.Args) == 0 {
+		return "{}"
+	}
+	items := make([]string, 0, len(call.Args)/2)
+	for i := 0; i+1 < len(call.Args); i += 2 {
+		key := string(f.TextAt(call.Args[i].Range()).Bytes)
+		if _, ok := literalString(call.Args[i]); !ok {
+			key = "(" + key + ")"
+		}
+		items = append(items, fmt.Sprintf("%s = %s", key, f.TextAt(call.Args[i+1].Range()).Bytes))
+	}
+	return "{ " + strings.Join(items, ", ") + " }"
+}
@@ -0,0 +1,146 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_DeprecatedFunctionsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Fixed    map[string]string
+	}{
+		{
+			Name: "literals",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  zones = tolist(["a", "b"])
+  tags  = { Name = "web" }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "list",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  zones = list("a", var.zone)
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewDeprecatedFunctionsRule(),
+					Message: "list() was removed in Terraform v0.15, use a tuple [ ... ] or tolist() instead",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"main.tf": `
+locals {
+  zones = ["a", var.zone]
+}
+`,
+			},
+		},
+		{
+			Name: "map",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  tags = map("Name", "web", var.key, 1)
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewDeprecatedFunctionsRule(),
+					Message: "map() was removed in Terraform v0.15, use an object { ... } or tomap() instead",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 3, Column: 40},
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"main.tf": `
+locals {
+  tags = { "Name" = "web", (var.key) = 1 }
+}
+`,
+			},
+		},
+		{
+			Name: "variable type constraints",
+			Content: map[string]string{
+				"main.tf": `
+variable "zones" {
+  type    = list(string)
+  default = []
+}
+
+variable "tags" {
+  type = map(string)
+}
+
+variable "rules" {
+  type = map(list(string))
+}
+`,
+			},
+			Expected: helper.Issues{},
+			Fixed:    map[string]string{},
+		},
+		{
+			Name: "expanded argument",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  zones = list(var.zones...)
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewDeprecatedFunctionsRule(),
+					Message: "list() was removed in Terraform v0.15, use a tuple [ ... ] or tolist() instead",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+			Fixed: map[string]string{},
+		},
+	}
+
+	rule := NewDeprecatedFunctionsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			if tc.Fixed != nil {
+				helper.AssertChanges(t, tc.Fixed, runner.Changes())
+			}
+		})
+	}
+}
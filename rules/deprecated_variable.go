@@ -0,0 +1,167 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/jforde/tflint-ruleset-hackathon/internal/refs"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// deprecatedVariableRuleConfig is the config structure for the deprecated_variable rule
+type deprecatedVariableRuleConfig struct {
+	Marker string `hclext:"marker,optional"`
+}
+
+// DeprecatedVariableRule checks whether deprecated variables name a replacement and are no longer referenced
+type DeprecatedVariableRule struct {
+	tflint.DefaultRule
+}
+
+// NewDeprecatedVariableRule returns a new rule
+func NewDeprecatedVariableRule() *DeprecatedVariableRule {
+	return &DeprecatedVariableRule{}
+}
+
+// Name returns the rule name
+func (r *DeprecatedVariableRule) Name() string {
+	return "deprecated_variable"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *DeprecatedVariableRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *DeprecatedVariableRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *DeprecatedVariableRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *DeprecatedVariableRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require deprecated variables to name a replacement and disallow references to them outside compatibility shims",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "marker", Description: "Regular expression matching the descriptions of deprecated variables", Default: "(?i)\\bdeprecated:"},
+		},
+		Example: `
+variable "subnet_id" {
+  type        = string
+  description = "Deprecated: this module now supports several subnets."
+}
+
+resource "aws_instance" "web" {
+  subnet_id = var.subnet_id
+}
+`,
+	}
+}
+
+// Check emits issues for deprecated variables whose description names no other variable as the replacement,
+// and for references to deprecated variables. References from a block that also references the replacement,
+// such as coalesce(var.subnet_ids, [var.subnet_id]) in a local, are compatibility shims and allowed.
+func (r *DeprecatedVariableRule) Check(runner tflint.Runner) error {
+	config := &deprecatedVariableRuleConfig{Marker: `(?i)\bdeprecated:`}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	marker, err := regexp.Compile(config.Marker)
+	if err != nil {
+		return fmt.Errorf("invalid marker pattern %q: %w", config.Marker, err)
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "description"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	graph, err := moduleReferences(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		name := variable.Labels[0]
+		attr, exists := variable.Body.Attributes["description"]
+		if !exists {
+			continue
+		}
+		description, ok := literalDescription(attr)
+		if !ok || !marker.MatchString(description) {
+			continue
+		}
+
+		replacement := ""
+		for _, other := range body.Blocks {
+			candidate := other.Labels[0]
+			if candidate != name && regexp.MustCompile(`(^|[^\w-])`+regexp.QuoteMeta(candidate)+`($|[^\w-])`).MatchString(description) {
+				replacement = candidate
+				break
+			}
+		}
+		if replacement == "" {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("variable %q is deprecated but its description does not name the variable replacing it", name),
+				variable.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+
+		addr := "var." + name
+		uses := append([]refs.Reference{}, graph.To(addr)...)
+		sort.Slice(uses, func(i, j int) bool {
+			if uses[i].Range.Filename != uses[j].Range.Filename {
+				return uses[i].Range.Filename < uses[j].Range.Filename
+			}
+			return uses[i].Range.Start.Byte < uses[j].Range.Start.Byte
+		})
+		for _, ref := range uses {
+			if ref.From == addr || (replacement != "" && ref.From != "" && referencesAddr(graph.From(ref.From), "var."+replacement)) {
+				continue
+			}
+
+			message := fmt.Sprintf("var.%s is deprecated and should no longer be referenced", name)
+			if replacement != "" {
+				message = fmt.Sprintf("var.%s is deprecated, reference var.%s instead", name, replacement)
+			}
+			if err := runner.EmitIssue(r, message, ref.Range); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// referencesAddr returns whether any of the references points at the object at addr
+func referencesAddr(references []refs.Reference, addr string) bool {
+	for _, ref := range references {
+		if ref.Addr() == addr {
+			return true
+		}
+	}
+	return false
+}
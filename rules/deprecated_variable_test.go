@@ -0,0 +1,141 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_DeprecatedVariableRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "replacement and shim",
+			Content: `
+variable "subnet_id" {
+  type        = string
+  default     = null
+  description = "Deprecated: use subnet_ids instead."
+}
+
+variable "subnet_ids" {
+  type    = list(string)
+  default = []
+}
+
+locals {
+  subnet_ids = var.subnet_id == null ? var.subnet_ids : [var.subnet_id]
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing replacement and references",
+			Content: `
+variable "subnet_id" {
+  type        = string
+  default     = null
+  description = "Deprecated: use subnet_ids instead."
+}
+
+variable "subnet_ids" {
+  type    = list(string)
+  default = []
+}
+
+variable "ami" {
+  type        = string
+  description = "DEPRECATED: the AMI is looked up."
+
+  validation {
+    condition     = var.ami != ""
+    error_message = "The AMI must not be empty."
+  }
+}
+
+resource "aws_instance" "web" {
+  ami       = var.ami
+  subnet_id = var.subnet_id
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewDeprecatedVariableRule(),
+					Message: "var.subnet_id is deprecated, reference var.subnet_ids instead",
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 25, Column: 15},
+						End:      hcl.Pos{Line: 25, Column: 28},
+					},
+				},
+				{
+					Rule:    NewDeprecatedVariableRule(),
+					Message: `variable "ami" is deprecated but its description does not name the variable replacing it`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 13, Column: 1},
+						End:      hcl.Pos{Line: 13, Column: 15},
+					},
+				},
+				{
+					Rule:    NewDeprecatedVariableRule(),
+					Message: "var.ami is deprecated and should no longer be referenced",
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 24, Column: 15},
+						End:      hcl.Pos{Line: 24, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom marker",
+			Content: `
+variable "ami" {
+  type        = string
+  description = "[legacy] The AMI is looked up."
+}
+`,
+			Config: `
+rule "deprecated_variable" {
+  enabled = true
+  marker  = "^\\[legacy\\]"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewDeprecatedVariableRule(),
+					Message: `variable "ami" is deprecated but its description does not name the variable replacing it`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 15},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewDeprecatedVariableRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"variables.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// descriptionStyleRuleConfig is the config structure for the description_style rule
+type descriptionStyleRuleConfig struct {
+	Capitalized    *bool `hclext:"capitalized,optional"`
+	TrailingPeriod *bool `hclext:"trailing_period,optional"`
+}
+
+// DescriptionStyleRule checks whether variable and output descriptions are written as sentences
+type DescriptionStyleRule struct {
+	tflint.DefaultRule
+}
+
+// NewDescriptionStyleRule returns a new rule
+func NewDescriptionStyleRule() *DescriptionStyleRule {
+	return &DescriptionStyleRule{}
+}
+
+// Name returns the rule name
+func (r *DescriptionStyleRule) Name() string {
+	return "description_style"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *DescriptionStyleRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *DescriptionStyleRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *DescriptionStyleRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *DescriptionStyleRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require variable and output descriptions to start with a capital letter, end with a period and not repeat the name",
+		Tags:        []string{TagDocumentation},
+		Config: []ConfigOption{
+			{Name: "capitalized", Description: "Require descriptions to start with a capital letter", Default: "true"},
+			{Name: "trailing_period", Description: "Require descriptions to end with a period", Default: "true"},
+		},
+		Example: `
+variable "instance_type" {
+  description = "instance type"
+}
+`,
+	}
+}
+
+// Check emits issues for descriptions of variables and outputs that are not capitalized, do not end with a period,
+// or only repeat the name. Missing and empty descriptions are left to the *_description_required rules.
+func (r *DescriptionStyleRule) Check(runner tflint.Runner) error {
+	config := &descriptionStyleRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	capitalized := config.Capitalized == nil || *config.Capitalized
+	trailingPeriod := config.TrailingPeriod == nil || *config.TrailingPeriod
+
+	blockSchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "description"}},
+	}
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "variable", LabelNames: []string{"name"}, Body: blockSchema},
+			{Type: "output", LabelNames: []string{"name"}, Body: blockSchema},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, block := range body.Blocks {
+		attr, exists := block.Body.Attributes["description"]
+		if !exists {
+			continue
+		}
+		name := block.Labels[0]
+
+		err := runner.EvaluateExpr(attr.Expr, func(description string) error {
+			description = strings.TrimSpace(description)
+			if description == "" {
+				return nil
+			}
+
+			problems := []string{}
+			if normalizeDescription(description) == normalizeDescription(name) {
+				problems = append(problems, "should not just repeat the name")
+			}
+			if first, _ := utf8.DecodeRuneInString(description); capitalized && unicode.IsLower(first) {
+				problems = append(problems, "should start with a capital letter")
+			}
+			if trailingPeriod && !strings.HasSuffix(description, ".") {
+				problems = append(problems, "should end with a period")
+			}
+
+			for _, problem := range problems {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("description of %s %q %s", block.Type, name, problem),
+					attr.Expr.Range(),
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// normalizeDescription lowercases the text and drops punctuation and separators, so that "Instance type."
+// and instance_type compare equal
+func normalizeDescription(text string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_DescriptionStyleRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "sentences",
+			Content: map[string]string{
+				"main.tf": `
+variable "instance_type" {
+  description = "EC2 instance type of the web servers."
+}
+output "id" {
+  description = "ID of the instance."
+}
+variable "region" {}
+variable "zone" {
+  description = ""
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "lowercase without period",
+			Content: map[string]string{
+				"main.tf": `
+variable "instance_type" {
+  description = "type of the instance"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: `description of variable "instance_type" should start with a capital letter`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 39},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: `description of variable "instance_type" should end with a period`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "repeats the name",
+			Content: map[string]string{
+				"main.tf": `
+output "instance_id" {
+  description = "Instance ID."
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: `description of output "instance_id" should not just repeat the name`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "toggles disabled",
+			Content: map[string]string{
+				"main.tf": `
+variable "instance_type" {
+  description = "type of the instance"
+}
+`,
+				".tflint.hcl": `
+rule "description_style" {
+  enabled         = true
+  capitalized     = false
+  trailing_period = false
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewDescriptionStyleRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
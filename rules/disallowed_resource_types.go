@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// disallowedResourceTypesRuleConfig is the config structure for the disallowed_resource_types rule
+type disallowedResourceTypesRuleConfig struct {
+	Types map[string]string `hclext:"types,optional"`
+}
+
+// disallowedType is a compiled type pattern with its replacement guidance
+type disallowedType struct {
+	pattern  *regexp.Regexp
+	guidance string
+}
+
+// DisallowedResourceTypesRule checks whether resources and data sources use deny-listed types
+type DisallowedResourceTypesRule struct {
+	tflint.DefaultRule
+}
+
+// NewDisallowedResourceTypesRule returns a new rule
+func NewDisallowedResourceTypesRule() *DisallowedResourceTypesRule {
+	return &DisallowedResourceTypesRule{}
+}
+
+// Name returns the rule name
+func (r *DisallowedResourceTypesRule) Name() string {
+	return "disallowed_resource_types"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *DisallowedResourceTypesRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *DisallowedResourceTypesRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *DisallowedResourceTypesRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *DisallowedResourceTypesRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow deny-listed resource and data source types with custom guidance",
+		Tags:        []string{TagSecurity},
+		Config: []ConfigOption{
+			{Name: "types", Description: "Map of regular expressions of resource and data source types to guidance shown in the issue", Default: "{}"},
+		},
+		Example: `
+resource "aws_instance" "web" {}
+`,
+	}
+}
+
+// Check emits issues for resource and data blocks whose type matches a pattern in types,
+// using the configured guidance as the message
+func (r *DisallowedResourceTypesRule) Check(runner tflint.Runner) error {
+	config := &disallowedResourceTypesRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if len(config.Types) == 0 {
+		return nil
+	}
+
+	patterns := make([]string, 0, len(config.Types))
+	for p := range config.Types {
+		patterns = append(patterns, p)
+	}
+	sort.Strings(patterns)
+
+	disallowed := make([]disallowedType, 0, len(patterns))
+	for _, p := range patterns {
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return fmt.Errorf("invalid resource type pattern %q: %w", p, err)
+		}
+		disallowed = append(disallowed, disallowedType{pattern: pattern, guidance: config.Types[p]})
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: &hclext.BodySchema{}},
+			{Type: "data", LabelNames: []string{"type", "name"}, Body: &hclext.BodySchema{}},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, block := range body.Blocks {
+		for _, d := range disallowed {
+			if !d.pattern.MatchString(block.Labels[0]) {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf(`%s "%s" "%s" uses a disallowed type: %s`, block.Type, block.Labels[0], block.Labels[1], d.guidance),
+				block.DefRange,
+			); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_DisallowedResourceTypesRule(t *testing.T) {
+	config := `
+rule "disallowed_resource_types" {
+  enabled = true
+  types = {
+    "^aws_iam_user$"   = "use SSO roles"
+    "^aws_default_vpc" = "create a dedicated VPC"
+  }
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "nothing configured",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_iam_user" "deploy" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "allowed types",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_iam_role" "deploy" {}
+data "aws_iam_user" "x" {}
+`,
+				".tflint.hcl": `
+rule "disallowed_resource_types" {
+  enabled = true
+  types = {
+    "^aws_iam_users$" = "use SSO roles"
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "disallowed types",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_iam_user" "deploy" {}
+data "aws_default_vpc_id" "x" {}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewDisallowedResourceTypesRule(),
+					Message: `resource "aws_iam_user" "deploy" uses a disallowed type: use SSO roles`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 33},
+					},
+				},
+				{
+					Rule:    NewDisallowedResourceTypesRule(),
+					Message: `data "aws_default_vpc_id" "x" uses a disallowed type: create a dedicated VPC`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewDisallowedResourceTypesRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
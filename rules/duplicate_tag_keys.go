@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// DuplicateTagKeysRule checks whether tags set the same key twice or keys differing only by case
+type DuplicateTagKeysRule struct {
+	tflint.DefaultRule
+}
+
+// NewDuplicateTagKeysRule returns a new rule
+func NewDuplicateTagKeysRule() *DuplicateTagKeysRule {
+	return &DuplicateTagKeysRule{}
+}
+
+// Name returns the rule name
+func (r *DuplicateTagKeysRule) Name() string {
+	return "duplicate_tag_keys"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *DuplicateTagKeysRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *DuplicateTagKeysRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *DuplicateTagKeysRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *DuplicateTagKeysRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow tag keys that are set twice or differ only by case",
+		Tags:        []string{TagCorrectness},
+		Example: `
+resource "aws_instance" "web" {
+  tags = merge(
+    { Environment = "prod" },
+    { environment = "production" },
+  )
+}
+`,
+	}
+}
+
+// Check emits an issue for every literal tag key of a resource that repeats an earlier key, exactly or
+// ignoring case. Keys are collected from object literals and the object literal arguments of merge().
+func (r *DuplicateTagKeysRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "tags"}, {Name: "labels"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range body.Blocks {
+		for _, name := range []string{"tags", "labels"} {
+			attr, exists := resource.Body.Attributes[name]
+			if !exists {
+				continue
+			}
+
+			seen := map[string]string{}
+			for _, key := range literalTagKeys(attr.Expr) {
+				previous, ok := seen[strings.ToLower(key.name)]
+				if !ok {
+					seen[strings.ToLower(key.name)] = key.name
+					continue
+				}
+
+				message := fmt.Sprintf(`%s key %q of resource "%s" "%s" is set more than once, only the last value is used`, name, key.name, resource.Labels[0], resource.Labels[1])
+				if previous != key.name {
+					message = fmt.Sprintf(`%s key %q of resource "%s" "%s" differs from %q only by case, providers treat them as separate keys`, name, key.name, resource.Labels[0], resource.Labels[1], previous)
+				}
+				if err := runner.EmitIssue(r, message, key.rng); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// tagKey is a literal key of a tags expression
+type tagKey struct {
+	name string
+	rng  hcl.Range
+}
+
+// literalTagKeys returns the literal keys of object constructors in a tags expression in source order,
+// looking into the arguments of merge()
+func literalTagKeys(expr hcl.Expression) []tagKey {
+	keys := []tagKey{}
+	switch e := expr.(type) {
+	case *hclsyntax.ObjectConsExpr:
+		for _, item := range e.Items {
+			if name, ok := objectKey(item.KeyExpr); ok {
+				keys = append(keys, tagKey{name: name, rng: item.KeyExpr.Range()})
+			}
+		}
+	case *hclsyntax.FunctionCallExpr:
+		if e.Name == "merge" {
+			for _, arg := range e.Args {
+				keys = append(keys, literalTagKeys(arg)...)
+			}
+		}
+	}
+	return keys
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_DuplicateTagKeysRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "distinct keys",
+			Content: `
+resource "aws_instance" "web" {
+  tags = merge(var.tags, {
+    Environment = "prod"
+    Name        = "web"
+  })
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "duplicate and case-variant keys",
+			Content: `
+resource "aws_instance" "web" {
+  tags = merge(
+    { Environment = "prod", Name = "web" },
+    { "environment" = "production" },
+    local.tags,
+    { Name = "web-1" },
+  )
+}
+
+resource "google_compute_instance" "web" {
+  labels = {
+    team = "platform"
+    Team = "platform"
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewDuplicateTagKeysRule(),
+					Message: `tags key "environment" of resource "aws_instance" "web" differs from "Environment" only by case, providers treat them as separate keys`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 7},
+						End:      hcl.Pos{Line: 5, Column: 20},
+					},
+				},
+				{
+					Rule:    NewDuplicateTagKeysRule(),
+					Message: `tags key "Name" of resource "aws_instance" "web" is set more than once, only the last value is used`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 7},
+						End:      hcl.Pos{Line: 7, Column: 11},
+					},
+				},
+				{
+					Rule:    NewDuplicateTagKeysRule(),
+					Message: `labels key "Team" of resource "google_compute_instance" "web" differs from "team" only by case, providers treat them as separate keys`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 14, Column: 5},
+						End:      hcl.Pos{Line: 14, Column: 9},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewDuplicateTagKeysRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
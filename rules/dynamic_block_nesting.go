@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// dynamicBlockNestingRuleConfig is the config structure for the dynamic_block_nesting rule
+type dynamicBlockNestingRuleConfig struct {
+	MaxDepth int `hclext:"max_depth,optional"`
+}
+
+// DynamicBlockNestingRule checks whether dynamic blocks are nested too deeply
+type DynamicBlockNestingRule struct {
+	tflint.DefaultRule
+}
+
+// NewDynamicBlockNestingRule returns a new rule
+func NewDynamicBlockNestingRule() *DynamicBlockNestingRule {
+	return &DynamicBlockNestingRule{}
+}
+
+// Name returns the rule name
+func (r *DynamicBlockNestingRule) Name() string {
+	return "dynamic_block_nesting"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *DynamicBlockNestingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *DynamicBlockNestingRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *DynamicBlockNestingRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *DynamicBlockNestingRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Limit how deeply dynamic blocks are nested",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "max_depth", Description: "Maximum number of nested dynamic blocks", Default: "2"},
+		},
+		Example: `
+resource "aws_lb_listener" "this" {
+  dynamic "default_action" {
+    for_each = var.actions
+    content {
+      dynamic "forward" {
+        for_each = default_action.value.forward
+        content {
+          dynamic "target_group" {
+            for_each = forward.value.target_groups
+            content {
+              arn = target_group.value.arn
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`,
+	}
+}
+
+// Check emits an issue for each dynamic block nested deeper than max_depth. Dynamic blocks inside a reported block
+// are not reported again.
+func (r *DynamicBlockNestingRule) Check(runner tflint.Runner) error {
+	config := &dynamicBlockNestingRuleConfig{MaxDepth: 2}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			// JSON files are likely generated
+			continue
+		}
+		if err := r.checkBody(runner, body, 0, config.MaxDepth); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *DynamicBlockNestingRule) checkBody(runner tflint.Runner, body *hclsyntax.Body, depth int, maxDepth int) error {
+	for _, block := range body.Blocks {
+		nested := depth
+		if block.Type == "dynamic" {
+			nested++
+		}
+		if nested > maxDepth {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("dynamic block %q is nested %d levels deep (max %d), build the nested values in locals instead", block.Labels[0], nested, maxDepth),
+				block.DefRange(),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := r.checkBody(runner, block.Body, nested, maxDepth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
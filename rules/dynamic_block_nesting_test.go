@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_DynamicBlockNestingRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "two levels",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_lb_listener" "this" {
+  dynamic "default_action" {
+    for_each = var.actions
+    content {
+      forward {
+        dynamic "target_group" {
+          for_each = default_action.value.target_groups
+          content {
+            arn = target_group.value.arn
+          }
+        }
+      }
+    }
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "three levels",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_lb_listener" "this" {
+  dynamic "default_action" {
+    for_each = var.actions
+    content {
+      dynamic "forward" {
+        for_each = default_action.value.forward
+        content {
+          dynamic "target_group" {
+            for_each = forward.value.target_groups
+            content {
+              dynamic "stickiness" {
+                for_each = target_group.value.stickiness
+                content {}
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewDynamicBlockNestingRule(),
+					Message: `dynamic block "target_group" is nested 3 levels deep (max 2), build the nested values in locals instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 9, Column: 11},
+						End:      hcl.Pos{Line: 9, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom depth",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_lb_listener" "this" {
+  dynamic "default_action" {
+    for_each = var.actions
+    content {
+      dynamic "forward" {
+        for_each = default_action.value.forward
+        content {}
+      }
+    }
+  }
+}
+`,
+				".tflint.hcl": `
+rule "dynamic_block_nesting" {
+  enabled   = true
+  max_depth = 1
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewDynamicBlockNestingRule(),
+					Message: `dynamic block "forward" is nested 2 levels deep (max 1), build the nested values in locals instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 7},
+						End:      hcl.Pos{Line: 6, Column: 24},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewDynamicBlockNestingRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// emptyFileRuleConfig is the config structure for the empty_file rule
+type emptyFileRuleConfig struct {
+	ExemptFiles []string `hclext:"exempt_files,optional"`
+}
+
+// EmptyFileRule checks whether the module contains .tf files without any declarations
+type EmptyFileRule struct {
+	tflint.DefaultRule
+}
+
+// NewEmptyFileRule returns a new rule
+func NewEmptyFileRule() *EmptyFileRule {
+	return &EmptyFileRule{}
+}
+
+// Name returns the rule name
+func (r *EmptyFileRule) Name() string {
+	return "empty_file"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *EmptyFileRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *EmptyFileRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *EmptyFileRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *EmptyFileRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow .tf files without blocks or attributes, except placeholder files such as variables.tf",
+		Tags:        []string{TagStructure},
+		Config: []ConfigOption{
+			{Name: "exempt_files", Description: "Names of files that may be empty", Default: `["variables.tf", "outputs.tf"]`},
+		},
+		Example: `
+# old_stuff.tf
+# resource "aws_instance" "legacy" {}
+`,
+	}
+}
+
+// Check emits an issue for every .tf file, other than the exempt files, that declares no blocks or attributes.
+// Files with only comments are empty too.
+func (r *EmptyFileRule) Check(runner tflint.Runner) error {
+	config := &emptyFileRuleConfig{ExemptFiles: []string{filenameVariables, filenameOutputs}}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	exempt := make(map[string]bool, len(config.ExemptFiles))
+	for _, name := range config.ExemptFiles {
+		exempt[name] = true
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if exempt[filepath.Base(name)] {
+			continue
+		}
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			// JSON files are likely generated
+			continue
+		}
+		if len(body.Blocks) > 0 || len(body.Attributes) > 0 {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s is empty, remove it", filepath.Base(name)),
+			hcl.Range{
+				Filename: name,
+				Start:    hcl.InitialPos,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
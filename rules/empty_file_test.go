@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_EmptyFileRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "placeholder files",
+			Content: map[string]string{
+				"main.tf":      `resource "null_resource" "a" {}`,
+				"variables.tf": "",
+				"outputs.tf":   "# No outputs yet\n",
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "empty files",
+			Content: map[string]string{
+				"main.tf":      `resource "null_resource" "a" {}`,
+				"old_stuff.tf": "# resource \"aws_instance\" \"legacy\" {}\n",
+				"tmp.tf":       "\n",
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewEmptyFileRule(),
+					Message: "old_stuff.tf is empty, remove it",
+					Range: hcl.Range{
+						Filename: "old_stuff.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+				{
+					Rule:    NewEmptyFileRule(),
+					Message: "tmp.tf is empty, remove it",
+					Range: hcl.Range{
+						Filename: "tmp.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+			},
+		},
+		{
+			Name: "custom exemptions",
+			Content: map[string]string{
+				"main.tf":      "",
+				"variables.tf": "",
+				".tflint.hcl": `
+rule "empty_file" {
+  enabled      = true
+  exempt_files = ["main.tf"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewEmptyFileRule(),
+					Message: "variables.tf is empty, remove it",
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewEmptyFileRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// enumVariableValidationRuleConfig is the config structure for the enum_variable_validation rule
+type enumVariableValidationRuleConfig struct {
+	NamePatterns        []string `hclext:"name_patterns,optional"`
+	DescriptionPatterns []string `hclext:"description_patterns,optional"`
+}
+
+// EnumVariableValidationRule checks whether string variables that take one of a set of values validate them with contains()
+type EnumVariableValidationRule struct {
+	tflint.DefaultRule
+}
+
+// NewEnumVariableValidationRule returns a new rule
+func NewEnumVariableValidationRule() *EnumVariableValidationRule {
+	return &EnumVariableValidationRule{}
+}
+
+// Name returns the rule name
+func (r *EnumVariableValidationRule) Name() string {
+	return "enum_variable_validation"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *EnumVariableValidationRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *EnumVariableValidationRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *EnumVariableValidationRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *EnumVariableValidationRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require string variables that take one of a set of values to validate them with contains()",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "name_patterns", Description: "Regular expressions of variable names that take one of a set of values", Default: `["^environment$", "_tier$"]`},
+			{Name: "description_patterns", Description: "Regular expressions of descriptions that list the allowed values", Default: `["(?i)\\bone of\\b", "(?i)\\b(allowed|valid|supported) values\\b"]`},
+		},
+		Example: `
+variable "environment" {
+  description = "Deployment environment, one of dev, staging or prod"
+  type        = string
+}
+`,
+	}
+}
+
+// Check emits issues for string variables whose name or description matches a pattern and that have no
+// validation condition calling contains() on the variable
+func (r *EnumVariableValidationRule) Check(runner tflint.Runner) error {
+	config := &enumVariableValidationRuleConfig{
+		NamePatterns:        []string{"^environment$", "_tier$"},
+		DescriptionPatterns: []string{`(?i)\bone of\b`, `(?i)\b(allowed|valid|supported) values\b`},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	namePatterns, err := compilePatterns("name", config.NamePatterns)
+	if err != nil {
+		return err
+	}
+	descriptionPatterns, err := compilePatterns("description", config.DescriptionPatterns)
+	if err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "type"}, {Name: "description"}},
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "validation",
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{{Name: "condition"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		name := variable.Labels[0]
+
+		typeAttr, exists := variable.Body.Attributes["type"]
+		if !exists {
+			continue
+		}
+		ty, diags := typeexpr.TypeConstraint(typeAttr.Expr)
+		if diags.HasErrors() || !ty.Equals(cty.String) {
+			continue
+		}
+
+		enum := matchesAny(namePatterns, name)
+		if attr, exists := variable.Body.Attributes["description"]; exists && !enum {
+			if description, ok := literalDescription(attr); ok {
+				enum = matchesAny(descriptionPatterns, description)
+			}
+		}
+		if !enum {
+			continue
+		}
+
+		validated := false
+		for _, validation := range variable.Body.Blocks {
+			if condition, exists := validation.Body.Attributes["condition"]; exists && validatesMembership(condition.Expr, name) {
+				validated = true
+				break
+			}
+		}
+		if validated {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("variable %q takes one of a set of values, add a validation block with condition = contains([...], var.%s)", name, name),
+			variable.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compilePatterns compiles the regular expressions of a config option, naming the option in errors
+func compilePatterns(kind string, exprs []string) ([]*regexp.Regexp, error) {
+	patterns := make([]*regexp.Regexp, 0, len(exprs))
+	for _, p := range exprs {
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s pattern %q: %w", kind, p, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
+// validatesMembership returns whether the condition calls contains() with var.<name> as an argument.
+// Conditions in JSON syntax cannot be inspected and are assumed to validate the variable.
+func validatesMembership(expr hcl.Expression, name string) bool {
+	native, ok := expr.(hclsyntax.Expression)
+	if !ok {
+		return true
+	}
+
+	found := false
+	hclsyntax.VisitAll(native, func(node hclsyntax.Node) hcl.Diagnostics {
+		call, ok := node.(*hclsyntax.FunctionCallExpr)
+		if !ok || call.Name != "contains" {
+			return nil
+		}
+		for _, arg := range call.Args {
+			for _, ref := range referencedNames(arg, "var") {
+				if ref == name {
+					found = true
+				}
+			}
+		}
+		return nil
+	})
+	return found
+}
@@ -0,0 +1,131 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_EnumVariableValidationRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "validated",
+			Content: `
+variable "environment" {
+  type = string
+
+  validation {
+    condition     = contains(["dev", "staging", "prod"], var.environment)
+    error_message = "Environment must be dev, staging or prod."
+  }
+}
+variable "db_tier" {
+  type = list(string)
+}
+variable "region" {
+  description = "AWS region to deploy to"
+  type        = string
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "name pattern",
+			Content: `
+variable "cache_tier" {
+  type = string
+
+  validation {
+    condition     = length(var.cache_tier) > 0
+    error_message = "Cache tier must not be empty."
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewEnumVariableValidationRule(),
+					Message: `variable "cache_tier" takes one of a set of values, add a validation block with condition = contains([...], var.cache_tier)`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "description pattern",
+			Content: `
+variable "log_level" {
+  description = "Log level, one of debug, info or warn"
+  type        = string
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewEnumVariableValidationRule(),
+					Message: `variable "log_level" takes one of a set of values, add a validation block with condition = contains([...], var.log_level)`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom patterns",
+			Content: `
+variable "environment" {
+  type = string
+}
+variable "storage_class" {
+  description = "Storage class: STANDARD | GLACIER"
+  type        = string
+}
+`,
+			Config: `
+rule "enum_variable_validation" {
+  enabled              = true
+  name_patterns        = []
+  description_patterns = ["\\|"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewEnumVariableValidationRule(),
+					Message: `variable "storage_class" takes one of a set of values, add a validation block with condition = contains([...], var.storage_class)`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 5, Column: 1},
+						End:      hcl.Pos{Line: 5, Column: 25},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewEnumVariableValidationRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"variables.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/internal/refs"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// environmentConditionalsRuleConfig is the config structure for the environment_conditionals rule
+type environmentConditionalsRuleConfig struct {
+	References      []string `hclext:"references,optional"`
+	MaxConditionals int      `hclext:"max_conditionals,optional"`
+}
+
+// EnvironmentConditionalsRule checks whether conditionals on the environment are scattered across a module
+type EnvironmentConditionalsRule struct {
+	tflint.DefaultRule
+}
+
+// NewEnvironmentConditionalsRule returns a new rule
+func NewEnvironmentConditionalsRule() *EnvironmentConditionalsRule {
+	return &EnvironmentConditionalsRule{}
+}
+
+// Name returns the rule name
+func (r *EnvironmentConditionalsRule) Name() string {
+	return "environment_conditionals"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *EnvironmentConditionalsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *EnvironmentConditionalsRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *EnvironmentConditionalsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *EnvironmentConditionalsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Limit conditionals on the environment in favour of a single lookup map in locals",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "references", Description: "References that hold the environment", Default: `["var.environment", "var.env", "local.environment", "local.env", "terraform.workspace"]`},
+			{Name: "max_conditionals", Description: "Maximum number of conditionals on the environment per module", Default: "2"},
+		},
+		Example: `
+resource "aws_instance" "app" {
+  instance_type = var.environment == "prod" ? "m5.large" : "t3.micro"
+  monitoring    = var.environment == "prod" ? true : false
+}
+
+resource "aws_db_instance" "app" {
+  multi_az = var.environment == "prod" ? true : false
+}
+`,
+	}
+}
+
+// Check emits an issue for every conditional on the environment once a module has more than max_conditionals of them
+func (r *EnvironmentConditionalsRule) Check(runner tflint.Runner) error {
+	config := &environmentConditionalsRuleConfig{
+		References:      []string{"var.environment", "var.env", "local.environment", "local.env", "terraform.workspace"},
+		MaxConditionals: 2,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	environment := make(map[string]bool, len(config.References))
+	for _, ref := range config.References {
+		environment[ref] = true
+	}
+
+	type conditional struct {
+		ref string
+		rng hcl.Range
+	}
+	conditionals := []conditional{}
+	diags := runner.WalkExpressions(tflint.ExprWalkFunc(func(expr hcl.Expression) hcl.Diagnostics {
+		cond, ok := expr.(*hclsyntax.ConditionalExpr)
+		if !ok {
+			return nil
+		}
+		for _, traversal := range cond.Condition.Variables() {
+			if ref := (refs.Reference{Traversal: traversal}).Key(2); environment[ref] {
+				conditionals = append(conditionals, conditional{ref: ref, rng: cond.Range()})
+				break
+			}
+		}
+		return nil
+	}))
+	if diags.HasErrors() {
+		return diags
+	}
+
+	if len(conditionals) <= config.MaxConditionals {
+		return nil
+	}
+	sort.Slice(conditionals, func(i, j int) bool {
+		if conditionals[i].rng.Filename != conditionals[j].rng.Filename {
+			return conditionals[i].rng.Filename < conditionals[j].rng.Filename
+		}
+		return conditionals[i].rng.Start.Byte < conditionals[j].rng.Start.Byte
+	})
+
+	for _, c := range conditionals {
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf(
+				"conditional on %s is one of %d in this module (max %d), look values up in a map in locals keyed by the environment instead",
+				c.ref,
+				len(conditionals),
+				config.MaxConditionals,
+			),
+			c.rng,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
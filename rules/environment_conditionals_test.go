@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_EnvironmentConditionalsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "within the limit",
+			Content: `
+resource "aws_instance" "app" {
+  instance_type = var.environment == "prod" ? "m5.large" : "t3.micro"
+  monitoring    = var.environment == "prod" ? true : false
+  ebs_optimized = var.enable_ebs ? true : false
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "over the limit",
+			Content: `
+resource "aws_instance" "app" {
+  instance_type = var.environment == "prod" ? "m5.large" : "t3.micro"
+  monitoring    = var.environment == "prod" ? true : false
+}
+
+resource "aws_db_instance" "app" {
+  multi_az = contains(["prod", "stage"], terraform.workspace) ? true : false
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewEnvironmentConditionalsRule(),
+					Message: "conditional on var.environment is one of 3 in this module (max 2), look values up in a map in locals keyed by the environment instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 19},
+						End:      hcl.Pos{Line: 3, Column: 70},
+					},
+				},
+				{
+					Rule:    NewEnvironmentConditionalsRule(),
+					Message: "conditional on var.environment is one of 3 in this module (max 2), look values up in a map in locals keyed by the environment instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 19},
+						End:      hcl.Pos{Line: 4, Column: 59},
+					},
+				},
+				{
+					Rule:    NewEnvironmentConditionalsRule(),
+					Message: "conditional on terraform.workspace is one of 3 in this module (max 2), look values up in a map in locals keyed by the environment instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 14},
+						End:      hcl.Pos{Line: 8, Column: 77},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom config",
+			Content: `
+resource "aws_instance" "app" {
+  instance_type = var.stage == "prod" ? "m5.large" : "t3.micro"
+  monitoring    = var.environment == "prod" ? true : false
+}
+`,
+			Config: `
+rule "environment_conditionals" {
+  enabled          = true
+  references       = ["var.stage"]
+  max_conditionals = 0
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewEnvironmentConditionalsRule(),
+					Message: "conditional on var.stage is one of 1 in this module (max 0), look values up in a map in locals keyed by the environment instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 19},
+						End:      hcl.Pos{Line: 3, Column: 64},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewEnvironmentConditionalsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"resource.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
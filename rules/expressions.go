@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// nestingWalker is an hclsyntax.Walker that tracks how deeply matching nodes are nested
+type nestingWalker struct {
+	match    func(hclsyntax.Node) bool
+	depth    int
+	maxDepth int
+}
+
+// Enter increments the depth when entering a matching node
+func (w *nestingWalker) Enter(node hclsyntax.Node) hcl.Diagnostics {
+	if w.match(node) {
+		w.depth++
+		if w.depth > w.maxDepth {
+			w.maxDepth = w.depth
+		}
+	}
+	return nil
+}
+
+// Exit decrements the depth when leaving a matching node
+func (w *nestingWalker) Exit(node hclsyntax.Node) hcl.Diagnostics {
+	if w.match(node) {
+		w.depth--
+	}
+	return nil
+}
+
+// nestingDepth returns how deeply the nodes matching match are nested within node, counting node itself.
+// For example, the depth of conditional expressions in a ? b : (c ? d : e) is 2.
+func nestingDepth(node hclsyntax.Node, match func(hclsyntax.Node) bool) int {
+	walker := &nestingWalker{match: match}
+	hclsyntax.Walk(node, walker)
+	return walker.maxDepth
+}
+
+// jsonString is a string literal found in a JSON configuration file
+type jsonString struct {
+	// path is the chain of object keys leading to the value, e.g. ["resource", "aws_instance", "web", "ami"]
+	path  []string
+	value string
+	rng   hcl.Range
+}
+
+// jsonStrings returns the string literals of a JSON body in source order. JSON has no syntax distinguishing
+// blocks from attributes, so objects and arrays are walked without a schema. Strings with interpolations are skipped.
+func jsonStrings(body hcl.Body) []jsonString {
+	attrs, _ := body.JustAttributes()
+
+	found := []jsonString{}
+	var walk func(expr hcl.Expression, path []string)
+	walk = func(expr hcl.Expression, path []string) {
+		if pairs, diags := hcl.ExprMap(expr); !diags.HasErrors() {
+			for _, pair := range pairs {
+				key, ok := literalString(pair.Key)
+				if !ok {
+					continue
+				}
+				walk(pair.Value, append(append([]string{}, path...), key))
+			}
+			return
+		}
+		if items, diags := hcl.ExprList(expr); !diags.HasErrors() {
+			for _, item := range items {
+				walk(item, path)
+			}
+			return
+		}
+		if value, ok := literalString(expr); ok {
+			found = append(found, jsonString{path: path, value: value, rng: expr.Range()})
+		}
+	}
+	for _, attr := range attrs {
+		walk(attr.Expr, []string{attr.Name})
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].rng.Start.Byte < found[j].rng.Start.Byte
+	})
+	return found
+}
@@ -0,0 +1,142 @@
+package rules
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// fileComplexityRuleConfig is the config structure for the file_complexity rule
+type fileComplexityRuleConfig struct {
+	MaxBlocks int `hclext:"max_blocks,optional"`
+	MaxLines  int `hclext:"max_lines,optional"`
+}
+
+// FileComplexityRule checks whether files grow beyond a manageable size
+type FileComplexityRule struct {
+	tflint.DefaultRule
+}
+
+// NewFileComplexityRule returns a new rule
+func NewFileComplexityRule() *FileComplexityRule {
+	return &FileComplexityRule{}
+}
+
+// Name returns the rule name
+func (r *FileComplexityRule) Name() string {
+	return "file_complexity"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *FileComplexityRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *FileComplexityRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *FileComplexityRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *FileComplexityRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Limit the number of resource and data blocks and lines per file",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "max_blocks", Description: "Maximum number of resource and data blocks per file, 0 disables the check", Default: "20"},
+			{Name: "max_lines", Description: "Maximum number of lines per file, 0 disables the check", Default: "500"},
+		},
+		Example: `
+# A main.tf with more than 20 resource and data blocks
+`,
+	}
+}
+
+// Check emits one issue per file that declares more resource and data blocks than max_blocks,
+// or has more lines than max_lines. A threshold of 0 disables that check.
+func (r *FileComplexityRule) Check(runner tflint.Runner) error {
+	config := &fileComplexityRuleConfig{
+		MaxBlocks: 20,
+		MaxLines:  500,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: &hclext.BodySchema{}},
+			{Type: "data", LabelNames: []string{"type", "name"}, Body: &hclext.BodySchema{}},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	blocks := map[string]int{}
+	for _, block := range body.Blocks {
+		blocks[block.DefRange.Filename]++
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if strings.HasSuffix(name, ".json") {
+			// JSON files are likely generated
+			continue
+		}
+
+		var problems []string
+		if count := blocks[name]; config.MaxBlocks > 0 && count > config.MaxBlocks {
+			problems = append(problems, fmt.Sprintf("%d resource and data blocks (max %d)", count, config.MaxBlocks))
+		}
+		if lines := countLines(files[name].Bytes); config.MaxLines > 0 && lines > config.MaxLines {
+			problems = append(problems, fmt.Sprintf("%d lines (max %d)", lines, config.MaxLines))
+		}
+		if len(problems) == 0 {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s has %s, split it into smaller files", name, strings.Join(problems, " and ")),
+			hcl.Range{
+				Filename: name,
+				Start:    hcl.InitialPos,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// countLines returns the number of lines in the source, counting a final line without a newline
+func countLines(src []byte) int {
+	lines := bytes.Count(src, []byte("\n"))
+	if len(src) > 0 && src[len(src)-1] != '\n' {
+		lines++
+	}
+	return lines
+}
@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_FileComplexityRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "small file",
+			Content: map[string]string{
+				"main.tf": `
+resource "null_resource" "a" {}
+data "null_data_source" "b" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "too many blocks",
+			Content: map[string]string{
+				"main.tf": `
+resource "null_resource" "a" {}
+resource "null_resource" "b" {}
+data "null_data_source" "c" {}
+`,
+				"other.tf": `
+resource "null_resource" "d" {}
+`,
+				".tflint.hcl": `
+rule "file_complexity" {
+  enabled    = true
+  max_blocks = 2
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewFileComplexityRule(),
+					Message: "main.tf has 3 resource and data blocks (max 2), split it into smaller files",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+			},
+		},
+		{
+			Name: "too many lines and blocks",
+			Content: map[string]string{
+				"main.tf": `resource "null_resource" "a" {}
+resource "null_resource" "b" {}
+locals {
+  a = 1
+}`,
+				".tflint.hcl": `
+rule "file_complexity" {
+  enabled    = true
+  max_blocks = 1
+  max_lines  = 4
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewFileComplexityRule(),
+					Message: "main.tf has 2 resource and data blocks (max 1) and 5 lines (max 4), split it into smaller files",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+			},
+		},
+		{
+			Name: "disabled threshold",
+			Content: map[string]string{
+				"main.tf": `
+resource "null_resource" "a" {}
+resource "null_resource" "b" {}
+`,
+				".tflint.hcl": `
+rule "file_complexity" {
+  enabled    = true
+  max_blocks = 0
+  max_lines  = 0
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewFileComplexityRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// fileHeaderYearPlaceholder is replaced by any year, or range of years, when matching the header
+const fileHeaderYearPlaceholder = "{{year}}"
+
+// fileHeaderRuleConfig is the config structure for the file_header rule
+type fileHeaderRuleConfig struct {
+	Header string `hclext:"header,optional"`
+}
+
+// FileHeaderRule checks whether every file starts with a required header comment
+type FileHeaderRule struct {
+	tflint.DefaultRule
+}
+
+// NewFileHeaderRule returns a new rule
+func NewFileHeaderRule() *FileHeaderRule {
+	return &FileHeaderRule{}
+}
+
+// Name returns the rule name
+func (r *FileHeaderRule) Name() string {
+	return "file_header"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *FileHeaderRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *FileHeaderRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *FileHeaderRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *FileHeaderRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require every .tf file to start with a header comment such as a license notice",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "header", Description: "Comment every file must start with. {{year}} matches any year such as 2024 or 2019-2024", Default: ""},
+		},
+		Example: `
+# With header = "# Copyright {{year}} Example Corp"
+resource "aws_s3_bucket" "main" {}
+`,
+	}
+}
+
+// Check emits an issue for every file that does not start with the header.
+// Files without a leading comment get a fix that inserts the header with the current year.
+func (r *FileHeaderRule) Check(runner tflint.Runner) error {
+	config := &fileHeaderRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	header := strings.TrimRight(strings.ReplaceAll(config.Header, "\r\n", "\n"), "\n")
+	if header == "" {
+		return nil
+	}
+
+	parts := strings.Split(header, fileHeaderYearPlaceholder)
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	pattern := regexp.MustCompile(`\A` + strings.Join(parts, `\d{4}(-\d{4})?`) + `(\n|\z)`)
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if filepath.Ext(name) == ".json" {
+			// JSON files have no comments
+			continue
+		}
+
+		src := bytes.ReplaceAll(files[name].Bytes, []byte("\r\n"), []byte("\n"))
+		if pattern.Match(src) {
+			continue
+		}
+
+		rng := hcl.Range{Filename: name, Start: hcl.InitialPos, End: hcl.InitialPos}
+		trimmed := bytes.TrimLeft(src, " \t\n")
+		if bytes.HasPrefix(trimmed, []byte("#")) || bytes.HasPrefix(trimmed, []byte("//")) || bytes.HasPrefix(trimmed, []byte("/*")) {
+			if err := runner.EmitIssue(r, fmt.Sprintf("%s does not start with the required header", name), rng); err != nil {
+				return err
+			}
+			continue
+		}
+
+		text := strings.ReplaceAll(header, fileHeaderYearPlaceholder, strconv.Itoa(time.Now().Year())) + "\n\n"
+		if err := runner.EmitIssueWithFix(
+			r,
+			fmt.Sprintf("%s is missing the required header", name),
+			rng,
+			func(f tflint.Fixer) error {
+				return f.InsertTextBefore(rng, text)
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_FileHeaderRule(t *testing.T) {
+	config := `
+rule "file_header" {
+  enabled = true
+  header  = <<-EOT
+    # Copyright {{year}} Example Corp
+    # SPDX-License-Identifier: Apache-2.0
+  EOT
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Fixed    map[string]string
+	}{
+		{
+			Name: "no header configured",
+			Content: map[string]string{
+				"main.tf": `resource "aws_s3_bucket" "main" {}`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "matching header",
+			Content: map[string]string{
+				"main.tf": `# Copyright 2019-2024 Example Corp
+# SPDX-License-Identifier: Apache-2.0
+
+resource "aws_s3_bucket" "main" {}
+`,
+				"outputs.tf": `# Copyright 2024 Example Corp
+# SPDX-License-Identifier: Apache-2.0
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "mismatching header",
+			Content: map[string]string{
+				"main.tf": `# Copyright 2024 Other Corp
+# SPDX-License-Identifier: Apache-2.0
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewFileHeaderRule(),
+					Message: "main.tf does not start with the required header",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.InitialPos,
+						End:      hcl.InitialPos,
+					},
+				},
+			},
+		},
+		{
+			Name: "missing header",
+			Content: map[string]string{
+				"main.tf":     `resource "aws_s3_bucket" "main" {}`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewFileHeaderRule(),
+					Message: "main.tf is missing the required header",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.InitialPos,
+						End:      hcl.InitialPos,
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"main.tf": `# Copyright ` + strconv.Itoa(time.Now().Year()) + ` Example Corp
+# SPDX-License-Identifier: Apache-2.0
+
+resource "aws_s3_bucket" "main" {}`,
+			},
+		},
+	}
+
+	rule := NewFileHeaderRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			if tc.Fixed != nil {
+				helper.AssertChanges(t, tc.Fixed, runner.Changes())
+			}
+		})
+	}
+}
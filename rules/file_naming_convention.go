@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const defaultFilenamePattern = `^[a-z0-9_]+$`
+
+// fileNamingConventionRuleConfig is the config structure for the file_naming_convention rule
+type fileNamingConventionRuleConfig struct {
+	Pattern string `hclext:"pattern,optional"`
+}
+
+// FileNamingConventionRule checks whether .tf filenames match a naming convention
+type FileNamingConventionRule struct {
+	tflint.DefaultRule
+}
+
+// NewFileNamingConventionRule returns a new rule
+func NewFileNamingConventionRule() *FileNamingConventionRule {
+	return &FileNamingConventionRule{}
+}
+
+// Name returns the rule name
+func (r *FileNamingConventionRule) Name() string {
+	return "file_naming_convention"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *FileNamingConventionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *FileNamingConventionRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *FileNamingConventionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *FileNamingConventionRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Enforce a naming convention on .tf filenames",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "pattern", Description: "Regular expression the filename without the .tf extension must match", Default: defaultFilenamePattern},
+		},
+		Example: `
+# A module with a file named My-Resources.TF
+`,
+	}
+}
+
+// Check emits issues for .tf files whose name does not match the pattern or whose extension is not lowercase.
+// JSON files are likely generated, so they are exempt.
+func (r *FileNamingConventionRule) Check(runner tflint.Runner) error {
+	config := &fileNamingConventionRuleConfig{Pattern: defaultFilenamePattern}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	pattern, err := regexp.Compile(config.Pattern)
+	if err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", config.Pattern, err)
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	// Terraform does not load files with an uppercase extension, so they are listed from the filesystem
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.EqualFold(filepath.Ext(name), ".tf") {
+			continue
+		}
+		if filepath.Ext(name) == ".tf" && pattern.MatchString(strings.TrimSuffix(name, ".tf")) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("filename %q must have a .tf extension and match the following RegExp: %s", name, config.Pattern),
+			hcl.Range{
+				Filename: filepath.Join(dir, name),
+				Start:    hcl.InitialPos,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
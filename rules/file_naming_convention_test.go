@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_FileNamingConventionRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   string
+		Files    map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name: "conventional filenames",
+			Files: map[string]string{
+				"variables.tf":           "",
+				"s3_bucket_v2.tf":        "",
+				"Generated-File.tf.json": "{}",
+				"README.md":              "",
+			},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "unconventional filenames",
+			Files: map[string]string{
+				"My-Resources.TF": "",
+				"dataSources.tf":  "",
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewFileNamingConventionRule(),
+						Message: `filename "My-Resources.TF" must have a .tf extension and match the following RegExp: ^[a-z0-9_]+$`,
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "My-Resources.TF"),
+							Start:    hcl.InitialPos,
+						},
+					},
+					{
+						Rule:    NewFileNamingConventionRule(),
+						Message: `filename "dataSources.tf" must have a .tf extension and match the following RegExp: ^[a-z0-9_]+$`,
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "dataSources.tf"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "custom pattern",
+			Config: `
+rule "file_naming_convention" {
+  enabled = true
+  pattern = "^[a-z-]+$"
+}
+`,
+			Files: map[string]string{
+				"data-sources.tf": "",
+			},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+	}
+
+	rule := NewFileNamingConventionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.Files)
+
+			content := map[string]string{filepath.Join(dir, "main.tf"): ""}
+			if tc.Config != "" {
+				content[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// blockRange returns the whole range of the native syntax block defined at def, from its type to the closing brace
+func blockRange(runner tflint.Runner, def hcl.Range) (hcl.Range, error) {
+	file, err := runner.GetFile(def.Filename)
+	if err != nil {
+		return hcl.Range{}, err
+	}
+	if file == nil {
+		return hcl.Range{}, fmt.Errorf("file not found: %s", def.Filename)
+	}
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return hcl.Range{}, tflint.ErrFixNotSupported
+	}
+
+	var rng hcl.Range
+	hclsyntax.VisitAll(body, func(node hclsyntax.Node) hcl.Diagnostics {
+		if block, ok := node.(*hclsyntax.Block); ok && block.TypeRange.Start.Byte == def.Start.Byte {
+			rng = block.Range()
+		}
+		return nil
+	})
+	if rng.Empty() {
+		return hcl.Range{}, fmt.Errorf("block not found at %s", def)
+	}
+	return rng, nil
+}
+
+// moveBlockFix returns a fix that moves the block to the end of the target file in the same directory.
+// The fixer cannot create files, so the fix is not supported when the target does not exist yet.
+func moveBlockFix(runner tflint.Runner, block *hclext.Block, target string) func(tflint.Fixer) error {
+	return func(f tflint.Fixer) error {
+		if filepath.Ext(block.DefRange.Filename) == ".json" {
+			return tflint.ErrFixNotSupported
+		}
+
+		files, err := runner.GetFiles()
+		if err != nil {
+			return err
+		}
+		targetPath := filepath.Join(filepath.Dir(block.DefRange.Filename), target)
+		targetFile, exists := files[targetPath]
+		if !exists || filepath.Ext(targetPath) == ".json" {
+			return tflint.ErrFixNotSupported
+		}
+
+		rng, err := blockRange(runner, block.DefRange)
+		if err != nil {
+			return err
+		}
+
+		text := string(f.TextAt(rng).Bytes) + "\n"
+		switch {
+		case len(bytes.TrimSpace(targetFile.Bytes)) == 0:
+		case bytes.HasSuffix(targetFile.Bytes, []byte("\n")):
+			text = "\n" + text
+		default:
+			text = "\n\n" + text
+		}
+		end := hcl.Pos{Byte: len(targetFile.Bytes)}
+		if err := f.InsertTextAfter(hcl.Range{Filename: targetPath, Start: end, End: end}, text); err != nil {
+			return err
+		}
+
+		return f.RemoveExtBlock(block)
+	}
+}
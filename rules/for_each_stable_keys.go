@@ -0,0 +1,202 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ForEachStableKeysRule checks whether for_each iterates over numeric ranges or lists with duplicate values
+type ForEachStableKeysRule struct {
+	tflint.DefaultRule
+}
+
+// NewForEachStableKeysRule returns a new rule
+func NewForEachStableKeysRule() *ForEachStableKeysRule {
+	return &ForEachStableKeysRule{}
+}
+
+// Name returns the rule name
+func (r *ForEachStableKeysRule) Name() string {
+	return "for_each_stable_keys"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ForEachStableKeysRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *ForEachStableKeysRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ForEachStableKeysRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ForEachStableKeysRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow for_each over numeric ranges or lists with duplicate values, use a map with stable keys",
+		Tags:        []string{TagBestPractice},
+		Example: `
+resource "aws_instance" "web" {
+  for_each = toset(range(3))
+}
+`,
+	}
+}
+
+// Check emits issues for resources, data sources and module calls whose for_each calls range(), since the
+// instance keys are then positions, or converts a list with duplicate values to a set, which silently drops them.
+func (r *ForEachStableKeysRule) Check(runner tflint.Runner) error {
+	blockSchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "for_each"}},
+	}
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: blockSchema},
+			{Type: "data", LabelNames: []string{"type", "name"}, Body: blockSchema},
+			{Type: "module", LabelNames: []string{"name"}, Body: blockSchema},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	evaluate := runnerEvaluator(runner)
+	for _, block := range body.Blocks {
+		forEach, exists := block.Body.Attributes["for_each"]
+		if !exists {
+			continue
+		}
+		expr, ok := forEach.Expr.(hclsyntax.Expression)
+		if !ok {
+			// The range() check needs native syntax nodes, so JSON files are skipped
+			continue
+		}
+
+		addr := block.Type
+		for _, label := range block.Labels {
+			addr += fmt.Sprintf(" %q", label)
+		}
+
+		if rangeKeys(expr) {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("for_each of %s iterates over range(), use a map with stable keys so instances are not recreated when the range changes", addr),
+				forEach.Expr.Range(),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		call, ok := expr.(*hclsyntax.FunctionCallExpr)
+		if !ok || call.Name != "toset" || len(call.Args) != 1 {
+			continue
+		}
+		value, err := evaluate(call.Args[0])
+		if err != nil {
+			return err
+		}
+		duplicates := duplicateValues(value)
+		if len(duplicates) == 0 {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("for_each of %s converts a list with duplicate values to a set, which drops %s; use a map with stable keys", addr, strings.Join(duplicates, ", ")),
+			forEach.Expr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rangeKeys returns whether the instance keys of a for_each expression come from range(): a bare range() call,
+// toset(range(...)), or a for expression over range() keyed by its iterator. Other uses of range(), such as
+// { for i in range(length(var.azs)) : var.azs[i] => i }, can produce stable keys.
+func rangeKeys(expr hclsyntax.Expression) bool {
+	switch expr := expr.(type) {
+	case *hclsyntax.FunctionCallExpr:
+		if expr.Name == "toset" && len(expr.Args) == 1 {
+			return rangeValues(expr.Args[0])
+		}
+	case *hclsyntax.ForExpr:
+		if expr.KeyExpr != nil {
+			return rangeValues(expr.CollExpr) && isIterator(expr.KeyExpr, expr)
+		}
+	}
+	return rangeValues(expr)
+}
+
+// rangeValues returns whether the expression is a range() call, or a list of its iterator over one
+func rangeValues(expr hclsyntax.Expression) bool {
+	switch expr := expr.(type) {
+	case *hclsyntax.FunctionCallExpr:
+		return expr.Name == "range"
+	case *hclsyntax.ForExpr:
+		return expr.KeyExpr == nil && rangeValues(expr.CollExpr) && isIterator(expr.ValExpr, expr)
+	}
+	return false
+}
+
+// isIterator returns whether the expression is just one of the iterator variables of the for expression
+func isIterator(expr hclsyntax.Expression, forExpr *hclsyntax.ForExpr) bool {
+	traversal, ok := expr.(*hclsyntax.ScopeTraversalExpr)
+	if !ok || len(traversal.Traversal) != 1 {
+		return false
+	}
+	name := traversal.Traversal.RootName()
+	return name == forExpr.ValVar || (forExpr.KeyVar != "" && name == forExpr.KeyVar)
+}
+
+// duplicateValues returns the values that occur more than once in a known list or tuple, formatted for messages
+func duplicateValues(value cty.Value) []string {
+	if !value.IsWhollyKnown() || value.IsNull() {
+		return nil
+	}
+	if !value.Type().IsListType() && !value.Type().IsTupleType() {
+		return nil
+	}
+
+	elems := value.AsValueSlice()
+	duplicates := []string{}
+	for i, elem := range elems {
+		// Report each value once, at its second occurrence
+		seen := 0
+		for _, prev := range elems[:i] {
+			if prev.RawEquals(elem) {
+				seen++
+			}
+		}
+		if seen == 1 {
+			duplicates = append(duplicates, formatValue(elem))
+		}
+	}
+	return duplicates
+}
+
+// formatValue returns a short representation of a primitive value for messages
+func formatValue(value cty.Value) string {
+	switch value.Type() {
+	case cty.String:
+		return fmt.Sprintf("%q", value.AsString())
+	case cty.Number:
+		return value.AsBigFloat().Text('f', -1)
+	case cty.Bool:
+		return fmt.Sprintf("%t", value.True())
+	default:
+		return value.GoString()
+	}
+}
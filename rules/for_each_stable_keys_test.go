@@ -0,0 +1,145 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ForEachStableKeysRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "map",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  for_each = { a = 1, b = 2 }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unique values",
+			Content: map[string]string{
+				"main.tf": `
+variable "names" {
+  default = ["a", "b"]
+}
+resource "aws_instance" "web" {
+  for_each = toset(var.names)
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unknown values",
+			Content: map[string]string{
+				"main.tf": `
+variable "names" {}
+module "web" {
+  for_each = toset(var.names)
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "range",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  for_each = toset(range(3))
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewForEachStableKeysRule(),
+					Message: `for_each of resource "aws_instance" "web" iterates over range(), use a map with stable keys so instances are not recreated when the range changes`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 14},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "range keyed by values",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_subnet" "private" {
+  for_each = { for i in range(length(var.azs)) : var.azs[i] => i }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "range keyed by iterator",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_subnet" "private" {
+  for_each = { for i in range(length(var.azs)) : i => var.azs[i] }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewForEachStableKeysRule(),
+					Message: `for_each of resource "aws_subnet" "private" iterates over range(), use a map with stable keys so instances are not recreated when the range changes`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 14},
+						End:      hcl.Pos{Line: 3, Column: 67},
+					},
+				},
+			},
+		},
+		{
+			Name: "duplicate values",
+			Content: map[string]string{
+				"main.tf": `
+variable "names" {
+  default = ["a", "b", "a", "c", "a", "b"]
+}
+data "aws_ami" "web" {
+  for_each = toset(var.names)
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewForEachStableKeysRule(),
+					Message: `for_each of data "aws_ami" "web" converts a list with duplicate values to a set, which drops "a", "b"; use a map with stable keys`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 14},
+						End:      hcl.Pos{Line: 6, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewForEachStableKeysRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
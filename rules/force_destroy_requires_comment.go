@@ -0,0 +1,153 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// justificationCommentPattern matches comments such as "# reason: test environment"
+var justificationCommentPattern = regexp.MustCompile(`^(#|//)\s*reason:\s*\S`)
+
+// justificationPropertyPattern matches the "//" comment properties of JSON files, such as "reason: test environment"
+var justificationPropertyPattern = regexp.MustCompile(`^\s*reason:\s*\S`)
+
+// ForceDestroyRequiresCommentRule checks whether force_destroy = true is justified with a comment
+type ForceDestroyRequiresCommentRule struct {
+	tflint.DefaultRule
+}
+
+// NewForceDestroyRequiresCommentRule returns a new rule
+func NewForceDestroyRequiresCommentRule() *ForceDestroyRequiresCommentRule {
+	return &ForceDestroyRequiresCommentRule{}
+}
+
+// Name returns the rule name
+func (r *ForceDestroyRequiresCommentRule) Name() string {
+	return "force_destroy_requires_comment"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ForceDestroyRequiresCommentRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *ForceDestroyRequiresCommentRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ForceDestroyRequiresCommentRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ForceDestroyRequiresCommentRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require a `# reason: ...` comment next to `force_destroy = true`",
+		Tags:        []string{TagSecurity},
+		Example: `
+resource "aws_s3_bucket" "logs" {
+  bucket        = "logs"
+  force_destroy = true
+}
+`,
+	}
+}
+
+// Check emits issues for force_destroy = true without a "# reason: ..." comment on the same or preceding line.
+// JSON files have no comments, so there the resource is justified by a "//" property starting with "reason:".
+func (r *ForceDestroyRequiresCommentRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "force_destroy"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range body.Blocks {
+		attr, exists := resource.Body.Attributes["force_destroy"]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(forceDestroy bool) error {
+			if !forceDestroy {
+				return nil
+			}
+
+			justified, err := r.hasJustification(runner, resource, attr.Range)
+			if err != nil {
+				return err
+			}
+			if justified {
+				return nil
+			}
+
+			message := "force_destroy = true should be justified with a \"# reason: ...\" comment on the same or preceding line"
+			if strings.HasSuffix(attr.Range.Filename, ".json") {
+				message = "force_destroy = true should be justified with a \"//\" property starting with \"reason:\" in the resource"
+			}
+			return runner.EmitIssue(r, message, attr.Range)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ForceDestroyRequiresCommentRule) hasJustification(runner tflint.Runner, resource *hclext.Block, attrRange hcl.Range) (bool, error) {
+	file, err := runner.GetFile(attrRange.Filename)
+	if err != nil {
+		return false, err
+	}
+	if file == nil {
+		return false, nil
+	}
+
+	if _, ok := file.Body.(*hclsyntax.Body); !ok {
+		comment := []string{"resource", resource.Labels[0], resource.Labels[1], "//"}
+		for _, str := range jsonStrings(file.Body) {
+			if strings.Join(str.path, ".") == strings.Join(comment, ".") && justificationPropertyPattern.MatchString(str.value) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	tokens, diags := hclsyntax.LexConfig(file.Bytes, attrRange.Filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return false, diags
+	}
+
+	for _, token := range tokens {
+		if token.Type != hclsyntax.TokenComment {
+			continue
+		}
+		line := token.Range.Start.Line
+		if line != attrRange.Start.Line && line != attrRange.Start.Line-1 {
+			continue
+		}
+		if justificationCommentPattern.Match(token.Bytes) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,137 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ForceDestroyRequiresCommentRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "inline comment",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_s3_bucket" "b" {
+  force_destroy = true # reason: test environment
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "preceding comment",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_s3_bucket" "b" {
+  # reason: ephemeral preview bucket
+  force_destroy = true
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "no comment",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_s3_bucket" "b" {
+  force_destroy = true
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewForceDestroyRequiresCommentRule(),
+					Message: `force_destroy = true should be justified with a "# reason: ..." comment on the same or preceding line`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "unrelated comment",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_s3_bucket" "b" {
+  force_destroy = true # TODO
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewForceDestroyRequiresCommentRule(),
+					Message: `force_destroy = true should be justified with a "# reason: ..." comment on the same or preceding line`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "JSON configuration",
+			Content: map[string]string{
+				"main.tf.json": `{
+  "resource": {
+    "aws_s3_bucket": {
+      "preview": {
+        "//": "reason: ephemeral preview bucket",
+        "force_destroy": true
+      },
+      "logs": {
+        "//": "access logs",
+        "force_destroy": true
+      }
+    }
+  }
+}`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewForceDestroyRequiresCommentRule(),
+					Message: "force_destroy = true should be justified with a \"//\" property starting with \"reason:\" in the resource",
+					Range: hcl.Range{
+						Filename: "main.tf.json",
+						Start:    hcl.Pos{Line: 10, Column: 9},
+						End:      hcl.Pos{Line: 10, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "force_destroy false",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_s3_bucket" "b" {
+  force_destroy = false
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewForceDestroyRequiresCommentRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
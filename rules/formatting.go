@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// FormattingRule checks whether files are formatted canonically, like terraform fmt
+type FormattingRule struct {
+	tflint.DefaultRule
+}
+
+// NewFormattingRule returns a new rule
+func NewFormattingRule() *FormattingRule {
+	return &FormattingRule{}
+}
+
+// Name returns the rule name
+func (r *FormattingRule) Name() string {
+	return "formatting"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *FormattingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *FormattingRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *FormattingRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *FormattingRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require files to match terraform fmt canonical formatting",
+		Tags:        []string{TagStyle},
+		Example: `
+resource "aws_instance" "web" {
+    ami = "ami-123"
+  instance_type = "t3.micro"
+}
+`,
+	}
+}
+
+// Check emits an issue for each file whose contents differ from the canonical formatting
+func (r *FormattingRule) Check(runner tflint.Runner) error {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if filepath.Ext(name) == ".json" {
+			// JSON files are likely generated
+			continue
+		}
+
+		src := files[name].Bytes
+		formatted := hclwrite.Format(src)
+		if bytes.Equal(src, formatted) {
+			continue
+		}
+
+		if err := runner.EmitIssueWithFix(
+			r,
+			fmt.Sprintf("%s is not formatted canonically, run terraform fmt", name),
+			hcl.Range{
+				Filename: name,
+				Start:    hcl.InitialPos,
+			},
+			func(f tflint.Fixer) error {
+				end := hcl.Pos{Byte: len(src)}
+				return f.ReplaceText(hcl.Range{Filename: name, Start: hcl.InitialPos, End: end}, string(formatted))
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
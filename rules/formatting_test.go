@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_FormattingRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Fixed    map[string]string
+	}{
+		{
+			Name: "formatted",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  ami           = "ami-123"
+  instance_type = "t3.micro"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unformatted",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+    ami = "ami-123"
+  instance_type = "t3.micro"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewFormattingRule(),
+					Message: "main.tf is not formatted canonically, run terraform fmt",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  ami           = "ami-123"
+  instance_type = "t3.micro"
+}
+`,
+			},
+		},
+		{
+			Name: "json",
+			Content: map[string]string{
+				"main.tf.json": `{"resource":   {}}`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewFormattingRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			if tc.Fixed != nil {
+				helper.AssertChanges(t, tc.Fixed, runner.Changes())
+			}
+		})
+	}
+}
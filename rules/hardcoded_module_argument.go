@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// HardcodedModuleArgumentRule checks whether module calls hardcode values the caller declares a variable for
+type HardcodedModuleArgumentRule struct {
+	tflint.DefaultRule
+}
+
+// NewHardcodedModuleArgumentRule returns a new rule
+func NewHardcodedModuleArgumentRule() *HardcodedModuleArgumentRule {
+	return &HardcodedModuleArgumentRule{}
+}
+
+// Name returns the rule name
+func (r *HardcodedModuleArgumentRule) Name() string {
+	return "hardcoded_module_argument"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *HardcodedModuleArgumentRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *HardcodedModuleArgumentRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *HardcodedModuleArgumentRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *HardcodedModuleArgumentRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow string literals as module arguments when the calling module declares a variable of the same name",
+		Tags:        []string{TagBestPractice},
+		Example: `
+variable "region" {
+  type = string
+}
+
+module "network" {
+  source = "./modules/network"
+  region = "eu-west-1"
+}
+`,
+	}
+}
+
+// Check emits an issue for every module argument set to a string literal while a variable of the same name
+// is declared in the calling module. The value was likely copied from another root and may diverge from var.<name>.
+func (r *HardcodedModuleArgumentRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "variable", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}},
+			{Type: "module", LabelNames: []string{"name"}, Body: &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode}},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	variables := map[string]bool{}
+	for _, block := range body.Blocks {
+		if block.Type == "variable" {
+			variables[block.Labels[0]] = true
+		}
+	}
+
+	for _, module := range body.Blocks {
+		if module.Type != "module" {
+			continue
+		}
+
+		args := make([]*hclext.Attribute, 0, len(module.Body.Attributes))
+		for name, attr := range module.Body.Attributes {
+			if !moduleMetaArguments[name] && variables[name] {
+				args = append(args, attr)
+			}
+		}
+		sort.Slice(args, func(i, j int) bool {
+			return args[i].Range.Start.Byte < args[j].Range.Start.Byte
+		})
+
+		for _, arg := range args {
+			value, ok := literalString(arg.Expr)
+			if !ok {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("argument %q of module %q is hardcoded to %q although var.%s is declared, pass the variable instead", arg.Name, module.Labels[0], value, arg.Name),
+				arg.Expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_HardcodedModuleArgumentRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "variables passed",
+			Content: `
+variable "region" {
+  type = string
+}
+
+module "network" {
+  source = "./modules/network"
+  region = var.region
+  name   = "core"
+  zones  = 3
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "hardcoded values",
+			Content: `
+variable "region" {
+  type = string
+}
+
+variable "environment" {
+  type = string
+}
+
+variable "zones" {
+  type = number
+}
+
+module "network" {
+  source      = "./modules/network"
+  region      = "eu-west-1"
+  environment = "${var.environment}"
+  zones       = 3
+}
+
+module "dns" {
+  source      = "./modules/dns"
+  environment = "prod"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewHardcodedModuleArgumentRule(),
+					Message: `argument "region" of module "network" is hardcoded to "eu-west-1" although var.region is declared, pass the variable instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 16, Column: 17},
+						End:      hcl.Pos{Line: 16, Column: 28},
+					},
+				},
+				{
+					Rule:    NewHardcodedModuleArgumentRule(),
+					Message: `argument "environment" of module "dns" is hardcoded to "prod" although var.environment is declared, pass the variable instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 23, Column: 17},
+						End:      hcl.Pos{Line: 23, Column: 23},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewHardcodedModuleArgumentRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ImportTargetExistsRule checks whether import blocks target resources declared in the module
+type ImportTargetExistsRule struct {
+	tflint.DefaultRule
+}
+
+// NewImportTargetExistsRule returns a new rule
+func NewImportTargetExistsRule() *ImportTargetExistsRule {
+	return &ImportTargetExistsRule{}
+}
+
+// Name returns the rule name
+func (r *ImportTargetExistsRule) Name() string {
+	return "import_target_exists"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ImportTargetExistsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *ImportTargetExistsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *ImportTargetExistsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ImportTargetExistsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require the to address of import blocks to be a resource declared in the module",
+		Tags:        []string{TagCorrectness},
+		Example: `
+resource "aws_instance" "web" {
+  ami = var.ami
+}
+
+import {
+  to = aws_instance.webb
+  id = "i-0123456789abcdef0"
+}
+`,
+	}
+}
+
+// Check emits issues for import blocks whose to address is not a resource address, or names a resource or
+// module call that is not declared. Resources within module calls cannot be checked without the called module.
+func (r *ImportTargetExistsRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: &hclext.BodySchema{}},
+			{Type: "module", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}},
+			{
+				Type: "import",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "to"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	declared := map[string]bool{}
+	for _, block := range body.Blocks {
+		switch block.Type {
+		case "resource":
+			declared[block.Labels[0]+"."+block.Labels[1]] = true
+		case "module":
+			declared["module."+block.Labels[0]] = true
+		}
+	}
+
+	for _, block := range body.Blocks {
+		if block.Type != "import" {
+			continue
+		}
+		to, exists := block.Body.Attributes["to"]
+		if !exists {
+			continue
+		}
+
+		addr, ok := refactoringAddress(to.Expr)
+		if !ok || strings.HasPrefix(addr, "data.") {
+			if err := runner.EmitIssue(
+				r,
+				"import block must target a resource address such as aws_instance.web",
+				to.Expr.Range(),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+		if declared[addr] {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("import block targets %s, which is not declared in this module", addr),
+			to.Expr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refactoringAddress returns the address of the resource, data source or module call an import or moved block
+// refers to, without instance keys: aws_instance.web for aws_instance.web[each.key], and module.network for
+// module.network.aws_vpc.main. It returns false if the expression is not such an address.
+func refactoringAddress(expr hcl.Expression) (string, bool) {
+	// Instance keys of import blocks with for_each are expressions, which are not part of a static traversal
+	if index, ok := expr.(*hclsyntax.IndexExpr); ok {
+		expr = index.Collection
+	}
+
+	traversal, diags := hcl.AbsTraversalForExpr(expr)
+	if diags.HasErrors() || len(traversal) < 2 {
+		return "", false
+	}
+	name, ok := traversal[1].(hcl.TraverseAttr)
+	if !ok {
+		return "", false
+	}
+	root := traversal.RootName()
+	if root == "module" {
+		// Anything after the module call addresses an object within the called module
+		return "module." + name.Name, true
+	}
+
+	rest := traversal[2:]
+	if root == "data" {
+		if len(rest) == 0 {
+			return "", false
+		}
+		resource, ok := rest[0].(hcl.TraverseAttr)
+		if !ok {
+			return "", false
+		}
+		root, name, rest = "data."+name.Name, resource, rest[1:]
+	}
+	if len(rest) > 0 {
+		if _, ok := rest[0].(hcl.TraverseIndex); ok {
+			rest = rest[1:]
+		}
+	}
+	return root + "." + name.Name, len(rest) == 0
+}
@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ImportTargetExistsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "declared targets",
+			Content: `
+resource "aws_instance" "web" {
+  for_each = var.instances
+  ami      = each.value
+}
+
+resource "aws_vpc" "main" {}
+
+module "network" {
+  source = "./modules/network"
+}
+
+import {
+  for_each = var.instances
+  to       = aws_instance.web[each.key]
+  id       = each.value
+}
+
+import {
+  to = aws_vpc.main
+  id = "vpc-0123456789abcdef0"
+}
+
+import {
+  to = module.network.aws_subnet.private["a"]
+  id = "subnet-0123456789abcdef0"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "undeclared and invalid targets",
+			Content: `
+resource "aws_instance" "web" {
+  ami = var.ami
+}
+
+import {
+  to = aws_instance.webb
+  id = "i-0123456789abcdef0"
+}
+
+import {
+  to = module.vpc.aws_vpc.main
+  id = "vpc-0123456789abcdef0"
+}
+
+import {
+  to = data.aws_ami.ubuntu
+  id = "ami-0123456789abcdef0"
+}
+
+import {
+  to = aws_instance.web.id
+  id = "i-0123456789abcdef0"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewImportTargetExistsRule(),
+					Message: "import block targets aws_instance.webb, which is not declared in this module",
+					Range: hcl.Range{
+						Filename: "imports.tf",
+						Start:    hcl.Pos{Line: 7, Column: 8},
+						End:      hcl.Pos{Line: 7, Column: 25},
+					},
+				},
+				{
+					Rule:    NewImportTargetExistsRule(),
+					Message: "import block targets module.vpc, which is not declared in this module",
+					Range: hcl.Range{
+						Filename: "imports.tf",
+						Start:    hcl.Pos{Line: 12, Column: 8},
+						End:      hcl.Pos{Line: 12, Column: 31},
+					},
+				},
+				{
+					Rule:    NewImportTargetExistsRule(),
+					Message: "import block must target a resource address such as aws_instance.web",
+					Range: hcl.Range{
+						Filename: "imports.tf",
+						Start:    hcl.Pos{Line: 17, Column: 8},
+						End:      hcl.Pos{Line: 17, Column: 27},
+					},
+				},
+				{
+					Rule:    NewImportTargetExistsRule(),
+					Message: "import block must target a resource address such as aws_instance.web",
+					Range: hcl.Range{
+						Filename: "imports.tf",
+						Start:    hcl.Pos{Line: 22, Column: 8},
+						End:      hcl.Pos{Line: 22, Column: 27},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewImportTargetExistsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"imports.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
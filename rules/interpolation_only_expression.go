@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// InterpolationOnlyExpressionRule checks for legacy 0.11-style interpolation-only expressions
+type InterpolationOnlyExpressionRule struct {
+	tflint.DefaultRule
+}
+
+// NewInterpolationOnlyExpressionRule returns a new rule
+func NewInterpolationOnlyExpressionRule() *InterpolationOnlyExpressionRule {
+	return &InterpolationOnlyExpressionRule{}
+}
+
+// Name returns the rule name
+func (r *InterpolationOnlyExpressionRule) Name() string {
+	return "interpolation_only_expression"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *InterpolationOnlyExpressionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *InterpolationOnlyExpressionRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *InterpolationOnlyExpressionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *InterpolationOnlyExpressionRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow legacy interpolation-only expressions such as \"${var.foo}\"",
+		Tags:        []string{TagCorrectness},
+		Example: `
+resource "aws_instance" "web" {
+  ami = "${var.ami}"
+}
+`,
+	}
+}
+
+// Check emits issues for templates like "${var.foo}" whose only content is a single interpolation
+func (r *InterpolationOnlyExpressionRule) Check(runner tflint.Runner) error {
+	diags := runner.WalkExpressions(tflint.ExprWalkFunc(func(expr hcl.Expression) hcl.Diagnostics {
+		wrap, ok := expr.(*hclsyntax.TemplateWrapExpr)
+		if !ok {
+			return nil
+		}
+
+		if err := runner.EmitIssueWithFix(
+			r,
+			"Interpolation-only expressions are deprecated in Terraform v0.12.14, use the bare expression instead",
+			expr.Range(),
+			func(f tflint.Fixer) error {
+				return f.ReplaceText(expr.Range(), f.TextAt(wrap.Wrapped.Range()))
+			},
+		); err != nil {
+			return hcl.Diagnostics{
+				{
+					Severity: hcl.DiagError,
+					Summary:  "failed to call EmitIssue()",
+					Detail:   err.Error(),
+				},
+			}
+		}
+		return nil
+	}))
+	if diags.HasErrors() {
+		return diags
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_InterpolationOnlyExpressionRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Fixed    map[string]string
+	}{
+		{
+			Name: "bare expression",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  ami  = var.ami
+  name = "web-${var.name}"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "interpolation only",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  ami = "${var.ami}"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewInterpolationOnlyExpressionRule(),
+					Message: "Interpolation-only expressions are deprecated in Terraform v0.12.14, use the bare expression instead",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 9},
+						End:      hcl.Pos{Line: 3, Column: 21},
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  ami = var.ami
+}
+`,
+			},
+		},
+	}
+
+	rule := NewInterpolationOnlyExpressionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			if tc.Fixed != nil {
+				helper.AssertChanges(t, tc.Fixed, runner.Changes())
+			}
+		})
+	}
+}
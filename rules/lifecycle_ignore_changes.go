@@ -0,0 +1,151 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// lifecycleIgnoreChangesRuleConfig is the config structure for the lifecycle_ignore_changes rule
+type lifecycleIgnoreChangesRuleConfig struct {
+	Patterns []string `hclext:"patterns,optional"`
+}
+
+// LifecycleIgnoreChangesRule checks whether lifecycle blocks ignore all changes or changes to denied arguments
+type LifecycleIgnoreChangesRule struct {
+	tflint.DefaultRule
+}
+
+// NewLifecycleIgnoreChangesRule returns a new rule
+func NewLifecycleIgnoreChangesRule() *LifecycleIgnoreChangesRule {
+	return &LifecycleIgnoreChangesRule{}
+}
+
+// Name returns the rule name
+func (r *LifecycleIgnoreChangesRule) Name() string {
+	return "lifecycle_ignore_changes"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *LifecycleIgnoreChangesRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *LifecycleIgnoreChangesRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *LifecycleIgnoreChangesRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *LifecycleIgnoreChangesRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow ignore_changes = all and ignoring changes to arguments matching configured patterns",
+		Tags:        []string{TagCorrectness},
+		Config: []ConfigOption{
+			{Name: "patterns", Description: "Regular expressions matching arguments whose changes must not be ignored", Default: "[]"},
+		},
+		Example: `
+resource "aws_instance" "web" {
+  ami           = var.ami
+  instance_type = "t3.micro"
+
+  lifecycle {
+    ignore_changes = all
+  }
+}
+`,
+	}
+}
+
+// Check emits issues for lifecycle blocks that ignore all changes, or changes to arguments matching patterns
+func (r *LifecycleIgnoreChangesRule) Check(runner tflint.Runner) error {
+	config := &lifecycleIgnoreChangesRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	patterns, err := compilePatterns("ignore_changes", config.Patterns)
+	if err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "lifecycle",
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{{Name: "ignore_changes"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range body.Blocks {
+		for _, lifecycle := range resource.Body.Blocks {
+			attr, exists := lifecycle.Body.Attributes["ignore_changes"]
+			if !exists {
+				continue
+			}
+
+			if hcl.ExprAsKeyword(attr.Expr) == "all" {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf(
+						`resource "%s" "%s" ignores all changes, list only the arguments that are managed outside Terraform`,
+						resource.Labels[0],
+						resource.Labels[1],
+					),
+					lifecycle.DefRange,
+				); err != nil {
+					return err
+				}
+				continue
+			}
+
+			exprs, diags := hcl.ExprList(attr.Expr)
+			if diags.HasErrors() {
+				continue
+			}
+			for _, expr := range exprs {
+				traversal, diags := hcl.AbsTraversalForExpr(expr)
+				if diags.HasErrors() || !matchesAny(patterns, traversal.RootName()) {
+					continue
+				}
+
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf(
+						`resource "%s" "%s" ignores changes to %s, drift in it will go unnoticed`,
+						resource.Labels[0],
+						resource.Labels[1],
+						traversal.RootName(),
+					),
+					lifecycle.DefRange,
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
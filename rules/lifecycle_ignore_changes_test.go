@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_LifecycleIgnoreChangesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "specific arguments",
+			Content: `
+resource "aws_autoscaling_group" "web" {
+  desired_capacity = 2
+
+  lifecycle {
+    ignore_changes = [desired_capacity, tags]
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "all",
+			Content: `
+resource "aws_instance" "web" {
+  ami = var.ami
+
+  lifecycle {
+    ignore_changes = all
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewLifecycleIgnoreChangesRule(),
+					Message: `resource "aws_instance" "web" ignores all changes, list only the arguments that are managed outside Terraform`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "denied arguments",
+			Content: `
+resource "aws_autoscaling_group" "web" {
+  desired_capacity = 2
+
+  lifecycle {
+    ignore_changes = [desired_capacity, tags, tags_all["Name"]]
+  }
+}
+`,
+			Config: `
+rule "lifecycle_ignore_changes" {
+  enabled  = true
+  patterns = ["^tags"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewLifecycleIgnoreChangesRule(),
+					Message: `resource "aws_autoscaling_group" "web" ignores changes to tags, drift in it will go unnoticed`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 12},
+					},
+				},
+				{
+					Rule:    NewLifecycleIgnoreChangesRule(),
+					Message: `resource "aws_autoscaling_group" "web" ignores changes to tags_all, drift in it will go unnoticed`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 12},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewLifecycleIgnoreChangesRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"resource.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
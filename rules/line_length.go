@@ -0,0 +1,138 @@
+package rules
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// lineLengthIgnoreFilePattern matches the comment that exempts a whole file from the line_length rule
+var lineLengthIgnoreFilePattern = regexp.MustCompile(`^(#|//|/\*)\s*line_length:\s*ignore-file\b`)
+
+// lineLengthRuleConfig is the config structure for the line_length rule
+type lineLengthRuleConfig struct {
+	MaxLength int `hclext:"max_length,optional"`
+}
+
+// LineLengthRule checks whether lines are longer than a maximum length
+type LineLengthRule struct {
+	tflint.DefaultRule
+}
+
+// NewLineLengthRule returns a new rule
+func NewLineLengthRule() *LineLengthRule {
+	return &LineLengthRule{}
+}
+
+// Name returns the rule name
+func (r *LineLengthRule) Name() string {
+	return "line_length"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *LineLengthRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *LineLengthRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *LineLengthRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *LineLengthRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Limit the length of lines, except in files with a `# line_length: ignore-file` comment",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "max_length", Description: "Maximum number of characters per line", Default: "120"},
+		},
+		Example: `
+locals {
+  description = "A description that keeps going and going, well past the point where it fits on a single line of the editor"
+}
+`,
+	}
+}
+
+// Check emits an issue for every line longer than max_length, measured in characters.
+// Files with a "# line_length: ignore-file" comment are skipped.
+func (r *LineLengthRule) Check(runner tflint.Runner) error {
+	config := &lineLengthRuleConfig{MaxLength: 120}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if filepath.Ext(name) == ".json" {
+			// JSON files are likely generated
+			continue
+		}
+
+		src := files[name].Bytes
+		if lineLengthIgnored(src, name) {
+			continue
+		}
+
+		offset := 0
+		for i, line := range bytes.Split(src, []byte("\n")) {
+			start := offset
+			offset += len(line) + 1
+
+			line = bytes.TrimSuffix(line, []byte("\r"))
+			length := utf8.RuneCount(line)
+			if length <= config.MaxLength {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Line is %d characters long (max %d)", length, config.MaxLength),
+				hcl.Range{
+					Filename: name,
+					Start:    hcl.Pos{Line: i + 1, Column: 1, Byte: start},
+					End:      hcl.Pos{Line: i + 1, Column: length + 1, Byte: start + len(line)},
+				},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// lineLengthIgnored returns whether the file has a comment exempting it from the line_length rule
+func lineLengthIgnored(src []byte, filename string) bool {
+	tokens, _ := hclsyntax.LexConfig(src, filename, hcl.InitialPos)
+	for _, token := range tokens {
+		if token.Type == hclsyntax.TokenComment && lineLengthIgnoreFilePattern.Match(token.Bytes) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_LineLengthRule(t *testing.T) {
+	long := `locals {
+  description = "` + strings.Repeat("a", 110) + `"
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "short lines",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  description = "short"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "long line",
+			Content: map[string]string{
+				"main.tf": long,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewLineLengthRule(),
+					Message: "Line is 128 characters long (max 120)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 129},
+					},
+				},
+			},
+		},
+		{
+			Name: "multibyte characters",
+			Content: map[string]string{
+				"main.tf": `locals {
+  description = "` + strings.Repeat("é", 100) + `"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "ignored file",
+			Content: map[string]string{
+				"main.tf": "# line_length: ignore-file\n" + long,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "custom length",
+			Content: map[string]string{
+				"main.tf": long,
+				".tflint.hcl": `
+rule "line_length" {
+  enabled    = true
+  max_length = 160
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewLineLengthRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package rules
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// absoluteSourcePattern matches Unix and Windows absolute paths
+var absoluteSourcePattern = regexp.MustCompile(`^(/|[A-Za-z]:[\\/])`)
+
+// localModuleSourceRuleConfig is the config structure for the local_module_source rule
+type localModuleSourceRuleConfig struct {
+	MaxParentSegments int `hclext:"max_parent_segments,optional"`
+}
+
+// LocalModuleSourceRule checks whether local module sources stay within the repository
+type LocalModuleSourceRule struct {
+	tflint.DefaultRule
+}
+
+// NewLocalModuleSourceRule returns a new rule
+func NewLocalModuleSourceRule() *LocalModuleSourceRule {
+	return &LocalModuleSourceRule{}
+}
+
+// Name returns the rule name
+func (r *LocalModuleSourceRule) Name() string {
+	return "local_module_source"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *LocalModuleSourceRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *LocalModuleSourceRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *LocalModuleSourceRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *LocalModuleSourceRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow absolute module sources and local sources that escape the repository",
+		Tags:        []string{TagCorrectness},
+		Config: []ConfigOption{
+			{Name: "max_parent_segments", Description: "Maximum number of .. segments in a local source, 0 disables the check", Default: "0"},
+		},
+		Example: `
+module "shared" {
+  source = "../../../shared"
+}
+`,
+	}
+}
+
+// Check emits issues for absolute module sources, local sources with too many .. segments,
+// and local sources that resolve outside the git repository containing the module
+func (r *LocalModuleSourceRule) Check(runner tflint.Runner) error {
+	config := &localModuleSourceRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "module",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "source"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, module := range body.Blocks {
+		attr, exists := module.Body.Attributes["source"]
+		if !exists {
+			continue
+		}
+		source, ok := literalString(attr.Expr)
+		if !ok {
+			continue
+		}
+
+		var message string
+		switch {
+		case absoluteSourcePattern.MatchString(source):
+			message = fmt.Sprintf("module %q source %q is an absolute path, use a path relative to the module", module.Labels[0], source)
+		case !isLocalSource(source):
+			continue
+		case config.MaxParentSegments > 0 && parentSegments(source) > config.MaxParentSegments:
+			message = fmt.Sprintf("module %q source %q climbs %d directories (max %d)", module.Labels[0], source, parentSegments(source), config.MaxParentSegments)
+		default:
+			escapes, err := escapesRepository(filepath.Dir(module.DefRange.Filename), source)
+			if err != nil {
+				return err
+			}
+			if !escapes {
+				continue
+			}
+			message = fmt.Sprintf("module %q source %q points outside the repository", module.Labels[0], source)
+		}
+
+		if err := runner.EmitIssue(r, message, attr.Range); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parentSegments returns the number of .. segments in a module source
+func parentSegments(source string) int {
+	count := 0
+	for _, segment := range strings.Split(filepath.ToSlash(source), "/") {
+		if segment == ".." {
+			count++
+		}
+	}
+	return count
+}
+
+// repositoryRoot returns the nearest directory containing .git, starting from the absolute path dir
+func repositoryRoot(dir string) (string, bool) {
+	for current := dir; ; current = filepath.Dir(current) {
+		if _, err := os.Stat(filepath.Join(current, ".git")); err == nil {
+			return current, true
+		}
+		if filepath.Dir(current) == current {
+			return "", false
+		}
+	}
+}
+
+// repositoryPath returns the slash-separated path of dir relative to the root of its git repository,
+// or relative to the working directory when dir is not in a git repository
+func repositoryPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	root, ok := repositoryRoot(abs)
+	if !ok {
+		if root, err = filepath.Abs("."); err != nil {
+			return "", err
+		}
+	}
+	rel, err := filepath.Rel(root, abs)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// escapesRepository returns whether the local source, relative to dir, resolves outside the git repository containing dir.
+// It returns false when dir is not in a git repository.
+func escapesRepository(dir string, source string) (bool, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return false, err
+	}
+
+	root, ok := repositoryRoot(abs)
+	if !ok {
+		return false, nil
+	}
+
+	// The // separator of a module subdirectory is cleaned along with the path
+	target := filepath.Join(abs, filepath.FromSlash(source))
+	rel, err := filepath.Rel(root, target)
+	if err != nil {
+		return false, err
+	}
+	return rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)), nil
+}
@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_LocalModuleSourceRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   string
+		Main     string
+		Expected func(filename string) helper.Issues
+	}{
+		{
+			Name: "sources within the repository",
+			Main: `
+module "shared" {
+  source = "../../shared//network"
+}
+module "registry" {
+  source = "hashicorp/consul/aws"
+}
+`,
+			Expected: func(filename string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "source outside the repository",
+			Main: `
+module "shared" {
+  source = "../../..//shared"
+}
+`,
+			Expected: func(filename string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewLocalModuleSourceRule(),
+						Message: `module "shared" source "../../..//shared" points outside the repository`,
+						Range: hcl.Range{
+							Filename: filename,
+							Start:    hcl.Pos{Line: 3, Column: 3},
+							End:      hcl.Pos{Line: 3, Column: 30},
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "absolute source",
+			Main: `
+module "shared" {
+  source = "/opt/modules/shared"
+}
+`,
+			Expected: func(filename string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewLocalModuleSourceRule(),
+						Message: `module "shared" source "/opt/modules/shared" is an absolute path, use a path relative to the module`,
+						Range: hcl.Range{
+							Filename: filename,
+							Start:    hcl.Pos{Line: 3, Column: 3},
+							End:      hcl.Pos{Line: 3, Column: 33},
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "too many parent segments",
+			Config: `
+rule "local_module_source" {
+  enabled             = true
+  max_parent_segments = 1
+}
+`,
+			Main: `
+module "shared" {
+  source = "../../shared"
+}
+`,
+			Expected: func(filename string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewLocalModuleSourceRule(),
+						Message: `module "shared" source "../../shared" climbs 2 directories (max 1)`,
+						Range: hcl.Range{
+							Filename: filename,
+							Start:    hcl.Pos{Line: 3, Column: 3},
+							End:      hcl.Pos{Line: 3, Column: 26},
+						},
+					},
+				}
+			},
+		},
+	}
+
+	rule := NewLocalModuleSourceRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, map[string]string{".git/HEAD": "ref: refs/heads/main"})
+
+			filename := filepath.Join(dir, "stacks", "prod", "main.tf")
+			content := map[string]string{filename: tc.Main}
+			if tc.Config != "" {
+				content[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(filename), runner.Issues)
+		})
+	}
+}
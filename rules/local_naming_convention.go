@@ -0,0 +1,140 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jforde/tflint-ruleset-hackathon/internal/naming"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// defaultDeniedLocalNames are local value names too generic to tell what they hold
+var defaultDeniedLocalNames = []string{"tmp", "temp", "data", "value", "values", "foo", "bar"}
+
+// localNamingConventionRuleConfig is the config structure for the local_naming_convention rule
+type localNamingConventionRuleConfig struct {
+	Format      string   `hclext:"format,optional"`
+	Custom      string   `hclext:"custom,optional"`
+	DeniedNames []string `hclext:"denied_names,optional"`
+	MinLength   int      `hclext:"min_length,optional"`
+}
+
+// LocalNamingConventionRule checks whether local value names follow a naming convention and are descriptive
+type LocalNamingConventionRule struct {
+	tflint.DefaultRule
+}
+
+// NewLocalNamingConventionRule returns a new rule
+func NewLocalNamingConventionRule() *LocalNamingConventionRule {
+	return &LocalNamingConventionRule{}
+}
+
+// Name returns the rule name
+func (r *LocalNamingConventionRule) Name() string {
+	return "local_naming_convention"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *LocalNamingConventionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *LocalNamingConventionRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *LocalNamingConventionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *LocalNamingConventionRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Enforce a naming convention on local value names and disallow generic names",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "format", Description: "snake_case, camelCase, PascalCase, kebab-case or custom", Default: "snake_case"},
+			{Name: "custom", Description: "Regular expression used when format is custom", Default: ""},
+			{Name: "denied_names", Description: "Names that are too generic to use", Default: `["tmp", "temp", "data", "value", "values", "foo", "bar"]`},
+			{Name: "min_length", Description: "Minimum length of names, 0 disables the check", Default: "2"},
+		},
+		Example: `
+locals {
+  tmp       = "${var.prefix}-app"
+  subnetIds = aws_subnet.private[*].id
+}
+`,
+	}
+}
+
+// Check emits issues for local value names that do not match the configured format, are denied or are too short
+func (r *LocalNamingConventionRule) Check(runner tflint.Runner) error {
+	format, custom := namingDefaults(runner)
+	config := &localNamingConventionRuleConfig{
+		Format:      format,
+		Custom:      custom,
+		DeniedNames: defaultDeniedLocalNames,
+		MinLength:   2,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	convention, err := naming.New(config.Format, config.Custom)
+	if err != nil {
+		return err
+	}
+	denied := make(map[string]bool, len(config.DeniedNames))
+	for _, name := range config.DeniedNames {
+		denied[name] = true
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "locals",
+				Body: &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	locals := []*hclext.Attribute{}
+	for _, block := range body.Blocks {
+		for _, attr := range block.Body.Attributes {
+			locals = append(locals, attr)
+		}
+	}
+	sort.Slice(locals, func(i, j int) bool {
+		if locals[i].Range.Filename != locals[j].Range.Filename {
+			return locals[i].Range.Filename < locals[j].Range.Filename
+		}
+		return locals[i].Range.Start.Byte < locals[j].Range.Start.Byte
+	})
+
+	for _, local := range locals {
+		var message string
+		switch name := local.Name; {
+		case !convention.Match(name):
+			message = fmt.Sprintf("local name %q must match the following %s", name, convention)
+		case denied[name]:
+			message = fmt.Sprintf("local name %q is too generic, name it after what it holds", name)
+		case config.MinLength > 0 && len(name) < config.MinLength:
+			message = fmt.Sprintf("local name %q is shorter than %d characters, name it after what it holds", name, config.MinLength)
+		default:
+			continue
+		}
+
+		if err := runner.EmitIssue(r, message, local.NameRange); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_LocalNamingConventionRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "descriptive snake_case names",
+			Content: `
+locals {
+  name_prefix        = "${var.prefix}-app"
+  private_subnet_ids = aws_subnet.private[*].id
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "invalid names",
+			Content: `
+locals {
+  subnetIds = aws_subnet.private[*].id
+  tmp       = "${var.prefix}-app"
+  x         = 1
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewLocalNamingConventionRule(),
+					Message: `local name "subnetIds" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "locals.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+				{
+					Rule:    NewLocalNamingConventionRule(),
+					Message: `local name "tmp" is too generic, name it after what it holds`,
+					Range: hcl.Range{
+						Filename: "locals.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 6},
+					},
+				},
+				{
+					Rule:    NewLocalNamingConventionRule(),
+					Message: `local name "x" is shorter than 2 characters, name it after what it holds`,
+					Range: hcl.Range{
+						Filename: "locals.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom config",
+			Content: `
+locals {
+  subnetIds = aws_subnet.private[*].id
+  tmp       = "${var.prefix}-app"
+  cfg       = {}
+}
+`,
+			Config: `
+rule "local_naming_convention" {
+  enabled      = true
+  format       = "camelCase"
+  denied_names = ["cfg"]
+  min_length   = 0
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewLocalNamingConventionRule(),
+					Message: `local name "cfg" is too generic, name it after what it holds`,
+					Range: hcl.Range{
+						Filename: "locals.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 6},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewLocalNamingConventionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"locals.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// leadingMetaArguments must come before any other argument or block
+var leadingMetaArguments = map[string]bool{"count": true, "for_each": true, "provider": true}
+
+// trailingMetaArguments must come after any other argument or block
+var trailingMetaArguments = map[string]bool{"depends_on": true, "lifecycle": true}
+
+// MetaArgumentOrderingRule checks whether meta-arguments are placed at the top and bottom of resource bodies
+type MetaArgumentOrderingRule struct {
+	tflint.DefaultRule
+}
+
+// NewMetaArgumentOrderingRule returns a new rule
+func NewMetaArgumentOrderingRule() *MetaArgumentOrderingRule {
+	return &MetaArgumentOrderingRule{}
+}
+
+// Name returns the rule name
+func (r *MetaArgumentOrderingRule) Name() string {
+	return "meta_argument_ordering"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *MetaArgumentOrderingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *MetaArgumentOrderingRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *MetaArgumentOrderingRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *MetaArgumentOrderingRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require meta-arguments at the top and bottom of resource and data blocks",
+		Tags:        []string{TagStyle},
+		Example: `
+resource "aws_instance" "web" {
+  ami   = var.ami
+  count = 2
+}
+`,
+	}
+}
+
+// Check emits an issue for the first misplaced meta-argument in each resource and data block.
+// count, for_each and provider belong at the top of the body, depends_on and lifecycle at the bottom.
+func (r *MetaArgumentOrderingRule) Check(runner tflint.Runner) error {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			// JSON files are likely generated
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" && block.Type != "data" {
+				continue
+			}
+			if err := r.checkBlock(runner, block); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *MetaArgumentOrderingRule) checkBlock(runner tflint.Runner, block *hclsyntax.Block) error {
+	items := bodyItems(block.Body)
+	label := fmt.Sprintf(`%s "%s"`, block.Type, strings.Join(block.Labels, `" "`))
+
+	for i := 1; i < len(items); i++ {
+		prev, item := items[i-1], items[i]
+		rank, prevRank := metaArgumentRank(item.name), metaArgumentRank(prev.name)
+		if rank >= prevRank {
+			continue
+		}
+
+		if rank == 0 {
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("%q should be at the top of %s", item.name, label),
+				item.rng,
+			)
+		}
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("%q should be at the bottom of %s", prev.name, label),
+			prev.rng,
+		)
+	}
+
+	return nil
+}
+
+// metaArgumentRank returns 0 for leading meta-arguments, 2 for trailing ones and 1 for everything else
+func metaArgumentRank(name string) int {
+	switch {
+	case leadingMetaArguments[name]:
+		return 0
+	case trailingMetaArguments[name]:
+		return 2
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_MetaArgumentOrderingRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "ordered",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  count    = 2
+  provider = aws.west
+
+  ami = "ami-123"
+  ebs_block_device {}
+
+  depends_on = [aws_vpc.main]
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "count after arguments",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  ami   = "ami-123"
+  count = 2
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewMetaArgumentOrderingRule(),
+					Message: `"count" should be at the top of resource "aws_instance" "web"`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "lifecycle before arguments",
+			Content: map[string]string{
+				"main.tf": `
+data "aws_ami" "ubuntu" {
+  lifecycle {}
+  most_recent = true
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewMetaArgumentOrderingRule(),
+					Message: `"lifecycle" should be at the bottom of data "aws_ami" "ubuntu"`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "other blocks are ignored",
+			Content: map[string]string{
+				"main.tf": `
+module "m" {
+  source = "./m"
+  count  = 2
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewMetaArgumentOrderingRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
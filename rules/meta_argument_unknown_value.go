@@ -0,0 +1,237 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/internal/refs"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// nonResourceRoots are the root names of references that do not point at managed resources
+var nonResourceRoots = map[string]bool{
+	"var": true, "local": true, "data": true, "module": true, "path": true,
+	"terraform": true, "each": true, "count": true, "self": true,
+}
+
+// MetaArgumentUnknownValueRule checks whether count and for_each depend on attributes only known after apply
+type MetaArgumentUnknownValueRule struct {
+	tflint.DefaultRule
+}
+
+// NewMetaArgumentUnknownValueRule returns a new rule
+func NewMetaArgumentUnknownValueRule() *MetaArgumentUnknownValueRule {
+	return &MetaArgumentUnknownValueRule{}
+}
+
+// Name returns the rule name
+func (r *MetaArgumentUnknownValueRule) Name() string {
+	return "meta_argument_unknown_value"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *MetaArgumentUnknownValueRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *MetaArgumentUnknownValueRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *MetaArgumentUnknownValueRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *MetaArgumentUnknownValueRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow count and for_each expressions that depend on resource attributes computed during apply",
+		Tags:        []string{TagCorrectness},
+		Example: `
+resource "aws_route_table_association" "private" {
+  for_each       = toset(aws_subnet.private[*].id)
+  subnet_id      = each.value
+  route_table_id = aws_route_table.private.id
+}
+`,
+	}
+}
+
+// Check emits an issue for every count and for_each of a resource, data source or module call that references,
+// directly or through locals, a resource attribute not set in that resource's configuration. Such attributes are
+// computed by the provider, so Terraform cannot determine the number of instances until apply.
+func (r *MetaArgumentUnknownValueRule) Check(runner tflint.Runner) error {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	configured := map[string]map[string]bool{}
+	locals := map[string]hclsyntax.Expression{}
+	metaArgs := []*hclsyntax.Attribute{}
+	owners := map[*hclsyntax.Attribute]string{}
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			// JSON files are likely generated
+			continue
+		}
+		for _, block := range body.Blocks {
+			switch block.Type {
+			case "locals":
+				for _, attr := range block.Body.Attributes {
+					locals[attr.Name] = attr.Expr
+				}
+				continue
+			case "resource":
+				if len(block.Labels) == 2 {
+					args := make(map[string]bool, len(block.Body.Attributes))
+					for attr := range block.Body.Attributes {
+						args[attr] = true
+					}
+					configured[block.Labels[0]+"."+block.Labels[1]] = args
+				}
+			case "data", "module":
+			default:
+				continue
+			}
+			for _, attr := range sortedAttributes(block.Body) {
+				if attr.Name == "count" || attr.Name == "for_each" {
+					metaArgs = append(metaArgs, attr)
+					owners[attr] = block.Type + " " + strings.Join(quoteLabels(block.Labels), " ")
+				}
+			}
+		}
+	}
+
+	for _, attr := range metaArgs {
+		found := map[string]bool{}
+		computedAttributes(attr.Expr, locals, map[string]bool{}, found)
+
+		unknown := []string{}
+		for ref := range found {
+			addr, name, _ := strings.Cut(ref, "#")
+			if args, ok := configured[addr]; ok && args[name] {
+				continue
+			}
+			unknown = append(unknown, addr+"."+name)
+		}
+		if len(unknown) == 0 {
+			continue
+		}
+		sort.Strings(unknown)
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf(
+				"%s of %s depends on %s, which is not known until apply, derive it from values known at plan time such as variables",
+				attr.Name,
+				owners[attr],
+				strings.Join(unknown, ", "),
+			),
+			attr.Expr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// computedAttributes collects the resource attributes the expression references, following references to locals.
+// Attributes are recorded as "<type>.<name>#<attribute>", splat expressions such as aws_subnet.private[*].id included.
+// The iterator variables of for expressions are not resources, so traversals rooted at them are skipped.
+func computedAttributes(expr hclsyntax.Expression, locals map[string]hclsyntax.Expression, visiting map[string]bool, found map[string]bool) {
+	iterators := map[string]bool{}
+	hclsyntax.VisitAll(expr, func(node hclsyntax.Node) hcl.Diagnostics {
+		if node, ok := node.(*hclsyntax.ForExpr); ok {
+			iterators[node.KeyVar] = node.KeyVar != ""
+			iterators[node.ValVar] = true
+		}
+		return nil
+	})
+
+	hclsyntax.VisitAll(expr, func(node hclsyntax.Node) hcl.Diagnostics {
+		switch node := node.(type) {
+		case *hclsyntax.ScopeTraversalExpr:
+			traversal := node.Traversal
+			if iterators[traversal.RootName()] {
+				return nil
+			}
+			if traversal.RootName() == "local" {
+				name := (refs.Reference{Traversal: traversal}).Key(2)
+				local, ok := locals[strings.TrimPrefix(name, "local.")]
+				if name != "" && ok && !visiting[name] {
+					visiting[name] = true
+					computedAttributes(local, locals, visiting, found)
+				}
+				return nil
+			}
+			if attr, ok := resourceAttribute(traversal, nil); ok {
+				found[attr] = true
+			}
+		case *hclsyntax.SplatExpr:
+			source, ok := node.Source.(*hclsyntax.ScopeTraversalExpr)
+			if !ok || iterators[source.Traversal.RootName()] {
+				return nil
+			}
+			each, ok := node.Each.(*hclsyntax.RelativeTraversalExpr)
+			if !ok {
+				return nil
+			}
+			if attr, ok := resourceAttribute(source.Traversal, each.Traversal); ok {
+				found[attr] = true
+			}
+		}
+		return nil
+	})
+}
+
+// resourceAttribute returns the "<type>.<name>#<attribute>" key of a traversal into a managed resource attribute,
+// skipping an instance index. The attribute may come from the relative traversal of a splat expression.
+func resourceAttribute(traversal hcl.Traversal, each hcl.Traversal) (string, bool) {
+	if traversal.IsRelative() || nonResourceRoots[traversal.RootName()] {
+		return "", false
+	}
+	addr := (refs.Reference{Traversal: traversal}).Key(2)
+	if addr == "" {
+		return "", false
+	}
+
+	steps := append(hcl.Traversal{}, traversal[2:]...)
+	if len(steps) > 0 {
+		if _, ok := steps[0].(hcl.TraverseIndex); ok {
+			steps = steps[1:]
+		}
+	}
+	steps = append(steps, each...)
+	if len(steps) == 0 {
+		return "", false
+	}
+	attr, ok := steps[0].(hcl.TraverseAttr)
+	if !ok {
+		return "", false
+	}
+	return addr + "#" + attr.Name, true
+}
+
+// quoteLabels returns the block labels in double quotes
+func quoteLabels(labels []string) []string {
+	quoted := make([]string, len(labels))
+	for i, label := range labels {
+		quoted[i] = fmt.Sprintf("%q", label)
+	}
+	return quoted
+}
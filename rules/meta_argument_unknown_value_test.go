@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_MetaArgumentUnknownValueRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "known at plan time",
+			Content: `
+resource "aws_subnet" "private" {
+  for_each   = var.subnets
+  cidr_block = each.value
+}
+
+resource "aws_route_table_association" "private" {
+  for_each  = aws_subnet.private
+  subnet_id = each.value.id
+}
+
+resource "aws_eip" "nat" {
+  count = length([for s in aws_subnet.private : s.cidr_block])
+}
+
+resource "aws_vpc_endpoint" "interface" {
+  for_each = { for k, s in var.subnets : s.tags.Name => k }
+}
+
+module "peer" {
+  source   = "./peer"
+  for_each = toset(data.aws_availability_zones.available.names)
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "computed attributes",
+			Content: `
+resource "aws_subnet" "private" {
+  count      = 2
+  cidr_block = cidrsubnet(var.cidr, 8, count.index)
+}
+
+locals {
+  subnet_ids = aws_subnet.private[*].id
+  ids        = local.subnet_ids
+}
+
+resource "aws_route_table_association" "private" {
+  for_each  = toset(local.ids)
+  subnet_id = each.value
+}
+
+data "aws_network_interface" "nat" {
+  count = aws_subnet.private[0].map_public_ip_on_launch ? 1 : 0
+}
+
+module "cidrs" {
+  source = "./cidrs"
+  count  = length(aws_subnet.private[*].cidr_block)
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewMetaArgumentUnknownValueRule(),
+					Message: `for_each of resource "aws_route_table_association" "private" depends on aws_subnet.private.id, which is not known until apply, derive it from values known at plan time such as variables`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 13, Column: 15},
+						End:      hcl.Pos{Line: 13, Column: 31},
+					},
+				},
+				{
+					Rule:    NewMetaArgumentUnknownValueRule(),
+					Message: `count of data "aws_network_interface" "nat" depends on aws_subnet.private.map_public_ip_on_launch, which is not known until apply, derive it from values known at plan time such as variables`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 18, Column: 11},
+						End:      hcl.Pos{Line: 18, Column: 64},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewMetaArgumentUnknownValueRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
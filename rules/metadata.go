@@ -0,0 +1,31 @@
+package rules
+
+import "github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+// Metadata is the documentation of a rule used to generate docs/rules.
+// Rules return it from the Metadata method of tflint.Rule.
+type Metadata struct {
+	// Description is a one-line summary of what the rule enforces
+	Description string
+	// Tags are the categories of the rule that presets are built from
+	Tags []string
+	// Config documents the attributes accepted in the rule block
+	Config []ConfigOption
+	// Example is a configuration that the rule reports
+	Example string
+	// References are links to external guidance the rule is based on
+	References []string
+}
+
+// metadataOf returns the metadata of the rule, or nil if the rule is not documented
+func metadataOf(rule tflint.Rule) *Metadata {
+	meta, _ := rule.Metadata().(*Metadata)
+	return meta
+}
+
+// ConfigOption documents an attribute of the rule block
+type ConfigOption struct {
+	Name        string
+	Description string
+	Default     string
+}
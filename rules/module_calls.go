@@ -0,0 +1,326 @@
+package rules
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// maxModuleCallDepth bounds how many levels of nested module calls deep module analysis follows
+const maxModuleCallDepth = 10
+
+// moduleMetaArguments are the module block arguments that are not input variables of the called module
+var moduleMetaArguments = map[string]bool{
+	"source":     true,
+	"version":    true,
+	"providers":  true,
+	"count":      true,
+	"for_each":   true,
+	"depends_on": true,
+}
+
+// evaluator evaluates an expression to a value, returning an unknown value when it cannot be determined
+type evaluator func(hcl.Expression) (cty.Value, error)
+
+// runnerEvaluator returns an evaluator that evaluates expressions of the inspected module with the runner
+func runnerEvaluator(runner tflint.Runner) evaluator {
+	return func(expr hcl.Expression) (cty.Value, error) {
+		value := cty.DynamicVal
+		err := runner.EvaluateExpr(expr, func(v cty.Value) error {
+			value = v
+			return nil
+		}, nil)
+		return value, err
+	}
+}
+
+// calledModule is a local module called directly or indirectly from the inspected module.
+// Called modules are not served by the runner, so they are parsed from the filesystem and evaluated
+// with the arguments of their call.
+type calledModule struct {
+	// addr is the address of the call relative to the inspected module, e.g. module.network.module.subnets
+	addr string
+	// callRange is the range of the module block in the inspected module that leads to this module
+	callRange hcl.Range
+	dir       string
+	files     map[string]*hcl.File
+	// variables are the values of the input variables, taken from the call arguments or the defaults
+	variables map[string]cty.Value
+}
+
+// deepModuleAnalysis returns whether rules should follow local module calls, as set by the plugin block
+func deepModuleAnalysis(runner tflint.Runner) bool {
+	return globalConfig(runner).DeepModuleAnalysis
+}
+
+// calledModules returns the local modules called from the inspected module and, recursively, from those modules.
+// It returns nothing unless deep module analysis is enabled. Rules opt in by calling it and reporting
+// problems found in a called module at its callRange.
+func calledModules(runner tflint.Runner) ([]*calledModule, error) {
+	if !deepModuleAnalysis(runner) {
+		return nil, nil
+	}
+	return localCalledModules(runner)
+}
+
+// localCalledModules returns the local modules called from the inspected module regardless of deep module analysis,
+// for rules with their own option to follow module calls.
+func localCalledModules(runner tflint.Runner) ([]*calledModule, error) {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "module",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return nil, err
+	}
+
+	modules := []*calledModule{}
+	for _, block := range body.Blocks {
+		attrs := make(hcl.Attributes, len(block.Body.Attributes))
+		for name, attr := range block.Body.Attributes {
+			attrs[name] = attr.AsNative()
+		}
+		called, err := loadCalledModules(fmt.Sprintf("module.%s", block.Labels[0]), block.DefRange, attrs, runnerEvaluator(runner), 1, map[string]bool{})
+		if err != nil {
+			return nil, err
+		}
+		modules = append(modules, called...)
+	}
+	return modules, nil
+}
+
+// loadCalledModules parses the module called by the module block with the given arguments,
+// followed by the modules it calls in turn. Calls to non-local sources are skipped.
+func loadCalledModules(addr string, callRange hcl.Range, attrs hcl.Attributes, evaluate evaluator, depth int, visiting map[string]bool) ([]*calledModule, error) {
+	sourceAttr, exists := attrs["source"]
+	if !exists || depth > maxModuleCallDepth {
+		return nil, nil
+	}
+	source, ok := literalString(sourceAttr.Expr)
+	if !ok || !isLocalSource(source) {
+		return nil, nil
+	}
+
+	dir := filepath.Join(filepath.Dir(sourceAttr.Range.Filename), source)
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if visiting[abs] {
+		// Recursive module calls are rejected by Terraform itself
+		return nil, nil
+	}
+	visiting[abs] = true
+	defer delete(visiting, abs)
+
+	files, err := parseModuleDir(dir)
+	if err != nil || len(files) == 0 {
+		// A called module that cannot be read is reported by terraform init, so it is skipped rather than
+		// failing the rule for the whole inspected module
+		return nil, nil
+	}
+
+	args := map[string]cty.Value{}
+	for name, attr := range attrs {
+		if moduleMetaArguments[name] {
+			continue
+		}
+		value, err := evaluate(attr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		args[name] = value
+	}
+
+	module := &calledModule{addr: addr, callRange: callRange, dir: dir, files: files, variables: map[string]cty.Value{}}
+	variables, err := module.content(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "default"}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, variable := range variables.Blocks {
+		name := variable.Labels[0]
+		value, exists := args[name]
+		if !exists {
+			value = cty.DynamicVal
+			if attr, exists := variable.Body.Attributes["default"]; exists {
+				if defaultValue, diags := attr.Expr.Value(nil); !diags.HasErrors() {
+					value = defaultValue
+				}
+			}
+		}
+		module.variables[name] = value
+	}
+
+	modules := []*calledModule{module}
+
+	calls, err := module.content(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "module",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, call := range calls.Blocks {
+		attrs := make(hcl.Attributes, len(call.Body.Attributes))
+		for name, attr := range call.Body.Attributes {
+			attrs[name] = attr.AsNative()
+		}
+		nested, err := loadCalledModules(fmt.Sprintf("%s.module.%s", addr, call.Labels[0]), callRange, attrs, module.evaluate, depth+1, visiting)
+		if err != nil {
+			return nil, err
+		}
+		modules = append(modules, nested...)
+	}
+
+	return modules, nil
+}
+
+// parseModuleDir parses the .tf and .tf.json files in dir, keyed by path
+func parseModuleDir(dir string) (map[string]*hcl.File, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	parser := hclparse.NewParser()
+	files := map[string]*hcl.File{}
+	for _, entry := range entries {
+		name := filepath.Join(dir, entry.Name())
+		var file *hcl.File
+		var diags hcl.Diagnostics
+		switch {
+		case entry.IsDir():
+			continue
+		case strings.HasSuffix(name, ".tf"):
+			file, diags = parser.ParseHCLFile(name)
+		case strings.HasSuffix(name, ".tf.json"):
+			file, diags = parser.ParseJSONFile(name)
+		default:
+			continue
+		}
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		files[name] = file
+	}
+	return files, nil
+}
+
+// content returns the content of every file of the module that matches the schema, in filename order
+func (m *calledModule) content(schema *hclext.BodySchema) (*hclext.BodyContent, error) {
+	return filesContent(m.files, schema)
+}
+
+// filesContent returns the content of every file that matches the schema, in filename order
+func filesContent(files map[string]*hcl.File, schema *hclext.BodySchema) (*hclext.BodyContent, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	content := &hclext.BodyContent{Attributes: hclext.Attributes{}, Blocks: hclext.Blocks{}}
+	for _, name := range names {
+		fileContent, diags := hclext.PartialContent(files[name].Body, schema)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		for attrName, attr := range fileContent.Attributes {
+			content.Attributes[attrName] = attr
+		}
+		content.Blocks = append(content.Blocks, fileContent.Blocks...)
+	}
+	return content, nil
+}
+
+// localModuleCall is a module block with a local source, read from a directory outside the runner
+type localModuleCall struct {
+	name   string
+	source string
+}
+
+// localModuleCalls returns the module calls with local sources in dir, sorted by name.
+// It shares parseModuleDir with calledModules, so missing and unreadable directories have no calls.
+func localModuleCalls(dir string) ([]localModuleCall, error) {
+	files, err := parseModuleDir(dir)
+	if err != nil {
+		return nil, nil
+	}
+
+	content, err := filesContent(files, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "module",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "source"}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	calls := []localModuleCall{}
+	for _, block := range content.Blocks {
+		attr, exists := block.Body.Attributes["source"]
+		if !exists {
+			continue
+		}
+		source, ok := literalString(attr.Expr)
+		if !ok || !isLocalSource(source) {
+			continue
+		}
+		calls = append(calls, localModuleCall{name: block.Labels[0], source: source})
+	}
+
+	sort.Slice(calls, func(i, j int) bool {
+		return calls[i].name < calls[j].name
+	})
+	return calls, nil
+}
+
+// evaluate evaluates an expression of the module with its input variables.
+// Expressions referring to anything else, or calling functions, evaluate to an unknown value.
+func (m *calledModule) evaluate(expr hcl.Expression) (cty.Value, error) {
+	ctx := &hcl.EvalContext{
+		Variables: map[string]cty.Value{"var": cty.ObjectVal(m.variables)},
+	}
+	value, diags := expr.Value(ctx)
+	if diags.HasErrors() {
+		return cty.DynamicVal, nil
+	}
+	return value, nil
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// moduleCallsPerFileRuleConfig is the config structure for the module_calls_per_file rule
+type moduleCallsPerFileRuleConfig struct {
+	MaxModules int `hclext:"max_modules,optional"`
+}
+
+// ModuleCallsPerFileRule checks whether a single file calls too many modules
+type ModuleCallsPerFileRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleCallsPerFileRule returns a new rule
+func NewModuleCallsPerFileRule() *ModuleCallsPerFileRule {
+	return &ModuleCallsPerFileRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleCallsPerFileRule) Name() string {
+	return "module_calls_per_file"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleCallsPerFileRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleCallsPerFileRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModuleCallsPerFileRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleCallsPerFileRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Limit the number of module calls per file",
+		Tags:        []string{TagStructure},
+		Config: []ConfigOption{
+			{Name: "max_modules", Description: "Maximum number of module blocks per file, 0 disables the check", Default: "10"},
+		},
+		Example: `
+# A main.tf with more than 10 module blocks
+`,
+	}
+}
+
+// Check emits one issue per file that declares more module blocks than max_modules, at the first call over the limit
+func (r *ModuleCallsPerFileRule) Check(runner tflint.Runner) error {
+	config := &moduleCallsPerFileRuleConfig{MaxModules: 10}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if config.MaxModules <= 0 {
+		return nil
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "module", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	calls := map[string][]*hclext.Block{}
+	for _, block := range body.Blocks {
+		calls[block.DefRange.Filename] = append(calls[block.DefRange.Filename], block)
+	}
+
+	names := make([]string, 0, len(calls))
+	for name := range calls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		blocks := calls[name]
+		if len(blocks) <= config.MaxModules {
+			continue
+		}
+		sort.Slice(blocks, func(i, j int) bool {
+			return blocks[i].DefRange.Start.Byte < blocks[j].DefRange.Start.Byte
+		})
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s has %d module calls (max %d), move related calls into purpose-named files", name, len(blocks), config.MaxModules),
+			blocks[config.MaxModules].DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleCallsPerFileRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "within limit",
+			Content: map[string]string{
+				"main.tf": `
+module "vpc" {
+  source = "./vpc"
+}
+module "dns" {
+  source = "./dns"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "too many calls",
+			Content: map[string]string{
+				"main.tf": `
+module "vpc" {
+  source = "./vpc"
+}
+module "dns" {
+  source = "./dns"
+}
+module "cdn" {
+  source = "./cdn"
+}
+`,
+				"data.tf": `
+module "db" {
+  source = "./db"
+}
+module "cache" {
+  source = "./cache"
+}
+`,
+				".tflint.hcl": `
+rule "module_calls_per_file" {
+  enabled     = true
+  max_modules = 2
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModuleCallsPerFileRule(),
+					Message: "main.tf has 3 module calls (max 2), move related calls into purpose-named files",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 1},
+						End:      hcl.Pos{Line: 8, Column: 13},
+					},
+				},
+			},
+		},
+		{
+			Name: "negative limit disables the check",
+			Content: map[string]string{
+				"main.tf": `
+module "vpc" {
+  source = "./vpc"
+}
+`,
+				".tflint.hcl": `
+rule "module_calls_per_file" {
+  enabled     = true
+  max_modules = -1
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleCallsPerFileRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const filenameChangelog = "CHANGELOG.md"
+
+// moduleChangelogRequiredRuleConfig is the config structure for the module_changelog_required rule
+type moduleChangelogRequiredRuleConfig struct {
+	Filename string `hclext:"filename,optional"`
+}
+
+// ModuleChangelogRequiredRule checks whether a module includes a changelog
+type ModuleChangelogRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleChangelogRequiredRule returns a new rule
+func NewModuleChangelogRequiredRule() *ModuleChangelogRequiredRule {
+	return &ModuleChangelogRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleChangelogRequiredRule) Name() string {
+	return "module_changelog_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleChangelogRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleChangelogRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModuleChangelogRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleChangelogRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require a CHANGELOG.md file in the module root",
+		Tags:        []string{TagDocumentation},
+		Config: []ConfigOption{
+			{Name: "filename", Description: "Name of the changelog file", Default: "CHANGELOG.md"},
+		},
+		Example: `
+# A module without a CHANGELOG.md file
+`,
+	}
+}
+
+// Check emits an issue when the changelog file does not exist in the module root
+func (r *ModuleChangelogRequiredRule) Check(runner tflint.Runner) error {
+	config := &moduleChangelogRequiredRuleConfig{Filename: filenameChangelog}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	if anyFileExists(dir, []string{config.Filename}) {
+		return nil
+	}
+
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf("Module should include a %s file describing the changes in each release", config.Filename),
+		hcl.Range{
+			Filename: filepath.Join(dir, config.Filename),
+			Start:    hcl.InitialPos,
+		},
+	)
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleChangelogRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   string
+		Files    map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name:     "CHANGELOG.md present",
+			Files:    map[string]string{"CHANGELOG.md": "## 1.0.0"},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name:  "missing changelog",
+			Files: map[string]string{},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleChangelogRequiredRule(),
+						Message: "Module should include a CHANGELOG.md file describing the changes in each release",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "CHANGELOG.md"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "custom filename",
+			Config: `
+rule "module_changelog_required" {
+  enabled  = true
+  filename = "HISTORY.md"
+}
+`,
+			Files:    map[string]string{"HISTORY.md": "## 1.0.0"},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+	}
+
+	rule := NewModuleChangelogRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.Files)
+
+			content := map[string]string{filepath.Join(dir, "main.tf"): ""}
+			if tc.Config != "" {
+				content[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
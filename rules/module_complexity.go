@@ -0,0 +1,160 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// moduleComplexityItems are the items counted towards the complexity score, in the order they are reported
+var moduleComplexityItems = []struct {
+	key   string
+	label string
+}{
+	{"resource", "resources"},
+	{"data", "data sources"},
+	{"module", "module calls"},
+	{"dynamic", "dynamic blocks"},
+}
+
+// defaultModuleComplexityWeights are the weights of the items when the config does not set them
+var defaultModuleComplexityWeights = map[string]int{
+	"resource": 1,
+	"data":     1,
+	"module":   3,
+	"dynamic":  2,
+}
+
+// moduleComplexityRuleConfig is the config structure for the module_complexity rule
+type moduleComplexityRuleConfig struct {
+	Budget  int            `hclext:"budget,optional"`
+	Weights map[string]int `hclext:"weights,optional"`
+}
+
+// ModuleComplexityRule checks whether the weighted size of a module exceeds a budget
+type ModuleComplexityRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleComplexityRule returns a new rule
+func NewModuleComplexityRule() *ModuleComplexityRule {
+	return &ModuleComplexityRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleComplexityRule) Name() string {
+	return "module_complexity"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleComplexityRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleComplexityRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModuleComplexityRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleComplexityRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Limit the weighted count of resources, data sources, module calls and dynamic blocks in a module",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "budget", Description: "Maximum complexity score of a module", Default: "50"},
+			{Name: "weights", Description: "Map of resource, data, module and dynamic to the score of each occurrence. Unset items keep their default", Default: "{ resource = 1, data = 1, module = 3, dynamic = 2 }"},
+		},
+		Example: `
+# A module with 40 resources and 5 module calls, scoring 55
+`,
+	}
+}
+
+// Check emits one issue summarizing the breakdown of the score when the module exceeds its budget
+func (r *ModuleComplexityRule) Check(runner tflint.Runner) error {
+	config := &moduleComplexityRuleConfig{Budget: 50}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	weights := make(map[string]int, len(defaultModuleComplexityWeights))
+	for key, weight := range defaultModuleComplexityWeights {
+		weights[key] = weight
+	}
+	for key, weight := range config.Weights {
+		if _, exists := weights[key]; !exists {
+			return fmt.Errorf("%q is an invalid weight. Valid weights are resource, data, module, and dynamic", key)
+		}
+		weights[key] = weight
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: &hclext.BodySchema{}},
+			{Type: "data", LabelNames: []string{"type", "name"}, Body: &hclext.BodySchema{}},
+			{Type: "module", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	counts := map[string]int{}
+	for _, block := range body.Blocks {
+		counts[block.Type]++
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+	for _, file := range files {
+		// Dynamic blocks are only counted in native syntax files
+		if syntaxBody, ok := file.Body.(*hclsyntax.Body); ok {
+			hclsyntax.VisitAll(syntaxBody, func(node hclsyntax.Node) hcl.Diagnostics {
+				if block, ok := node.(*hclsyntax.Block); ok && block.Type == "dynamic" {
+					counts["dynamic"]++
+				}
+				return nil
+			})
+		}
+	}
+
+	score := 0
+	breakdown := []string{}
+	for _, item := range moduleComplexityItems {
+		if counts[item.key] == 0 {
+			continue
+		}
+		score += counts[item.key] * weights[item.key]
+		breakdown = append(breakdown, fmt.Sprintf("%d %s x %d", counts[item.key], item.label, weights[item.key]))
+	}
+	if score <= config.Budget {
+		return nil
+	}
+
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf("Module complexity score is %d (budget %d): %s. Split the module into smaller modules", score, config.Budget, strings.Join(breakdown, ", ")),
+		hcl.Range{
+			Filename: filepath.Join(dir, filenameMain),
+			Start:    hcl.InitialPos,
+		},
+	)
+}
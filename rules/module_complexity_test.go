@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleComplexityRule(t *testing.T) {
+	content := `
+resource "aws_security_group" "this" {
+  dynamic "ingress" {
+    for_each = var.ingress
+    content {
+      dynamic "cidr" {
+        for_each = ingress.value.cidrs
+        content {}
+      }
+    }
+  }
+}
+resource "aws_instance" "this" {}
+data "aws_ami" "this" {}
+module "network" {
+  source = "./modules/network"
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "within the budget",
+			Content: map[string]string{
+				"main.tf": content,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "over the budget",
+			Content: map[string]string{
+				"main.tf": content,
+				".tflint.hcl": `
+rule "module_complexity" {
+  enabled = true
+  budget  = 8
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModuleComplexityRule(),
+					Message: "Module complexity score is 10 (budget 8): 2 resources x 1, 1 data sources x 1, 1 module calls x 3, 2 dynamic blocks x 2. Split the module into smaller modules",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+			},
+		},
+		{
+			Name: "custom weights",
+			Content: map[string]string{
+				"main.tf": content,
+				".tflint.hcl": `
+rule "module_complexity" {
+  enabled = true
+  budget  = 8
+  weights = {
+    module  = 1
+    dynamic = 1
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleComplexityRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
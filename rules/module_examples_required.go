@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const dirnameExamples = "examples"
+
+// ModuleExamplesRequiredRule checks whether a module ships runnable examples under examples/
+type ModuleExamplesRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleExamplesRequiredRule returns a new rule
+func NewModuleExamplesRequiredRule() *ModuleExamplesRequiredRule {
+	return &ModuleExamplesRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleExamplesRequiredRule) Name() string {
+	return "module_examples_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleExamplesRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleExamplesRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModuleExamplesRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleExamplesRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require at least one runnable example under examples/",
+		Tags:        []string{TagStructure},
+		Example: `
+# A module without an examples/ directory containing a .tf file
+`,
+	}
+}
+
+// Check emits an issue when the examples directory is missing or has no examples/<name>/main.tf
+func (r *ModuleExamplesRequiredRule) Check(runner tflint.Runner) error {
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	examplesDir := filepath.Join(dir, dirnameExamples)
+	entries, err := os.ReadDir(examplesDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return runner.EmitIssue(
+			r,
+			"Module should include an examples directory with at least one runnable example",
+			hcl.Range{
+				Filename: examplesDir,
+				Start:    hcl.InitialPos,
+			},
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(examplesDir, entry.Name(), filenameMain)); err == nil {
+			return nil
+		}
+	}
+
+	return runner.EmitIssue(
+		r,
+		"examples directory should contain at least one example with a main.tf file",
+		hcl.Range{
+			Filename: examplesDir,
+			Start:    hcl.InitialPos,
+		},
+	)
+}
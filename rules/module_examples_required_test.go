@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleExamplesRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Dirs     map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name: "example with main.tf",
+			Dirs: map[string]string{
+				"examples/basic/main.tf": "",
+			},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "missing examples directory",
+			Dirs: map[string]string{},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleExamplesRequiredRule(),
+						Message: "Module should include an examples directory with at least one runnable example",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "examples"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "empty example",
+			Dirs: map[string]string{
+				"examples/basic/README.md": "",
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleExamplesRequiredRule(),
+						Message: "examples directory should contain at least one example with a main.tf file",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "examples"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+	}
+
+	rule := NewModuleExamplesRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.Dirs)
+
+			runner := helper.TestRunner(t, map[string]string{filepath.Join(dir, "main.tf"): ""})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleExplicitProvidersRule checks whether module calls pass providers explicitly when aliased providers exist
+type ModuleExplicitProvidersRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleExplicitProvidersRule returns a new rule
+func NewModuleExplicitProvidersRule() *ModuleExplicitProvidersRule {
+	return &ModuleExplicitProvidersRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleExplicitProvidersRule) Name() string {
+	return "module_explicit_providers"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleExplicitProvidersRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *ModuleExplicitProvidersRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModuleExplicitProvidersRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleExplicitProvidersRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require module calls to pass providers explicitly when the module configures aliased providers",
+		Tags:        []string{TagCorrectness},
+		Example: `
+provider "aws" {
+  alias  = "production"
+  region = "us-east-1"
+}
+
+module "network" {
+  source = "./modules/network"
+}
+`,
+	}
+}
+
+// Check emits issues for module calls without a providers argument in modules that configure aliased providers.
+// Module calls only inherit the default provider configurations, so an aliased one is easily left out by accident.
+func (r *ModuleExplicitProvidersRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "provider",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "alias"}},
+				},
+			},
+			{
+				Type:       "module",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "providers"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	blocks := body.Blocks.ByType()
+
+	aliases := []string{}
+	for _, provider := range blocks["provider"] {
+		attr, exists := provider.Body.Attributes["alias"]
+		if !exists {
+			continue
+		}
+		if alias, ok := literalString(attr.Expr); ok {
+			aliases = append(aliases, fmt.Sprintf("%s.%s", provider.Labels[0], alias))
+		} else {
+			aliases = append(aliases, provider.Labels[0])
+		}
+	}
+	if len(aliases) == 0 {
+		return nil
+	}
+	sort.Strings(aliases)
+
+	for _, module := range blocks["module"] {
+		if _, exists := module.Body.Attributes["providers"]; exists {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("module %q should pass providers explicitly because aliased providers are configured: %s", module.Labels[0], strings.Join(aliases, ", ")),
+			module.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleExplicitProvidersRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no aliased providers",
+			Content: map[string]string{
+				"main.tf": `
+provider "aws" {
+  region = "us-east-1"
+}
+module "network" {
+  source = "./modules/network"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "providers passed",
+			Content: map[string]string{
+				"main.tf": `
+provider "aws" {
+  alias = "production"
+}
+module "network" {
+  source = "./modules/network"
+  providers = {
+    aws = aws.production
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "providers not passed",
+			Content: map[string]string{
+				"main.tf": `
+provider "aws" {
+  region = "us-east-1"
+}
+provider "aws" {
+  alias = "production"
+}
+provider "google" {
+  alias = "shared"
+}
+module "network" {
+  source = "./modules/network"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModuleExplicitProvidersRule(),
+					Message: `module "network" should pass providers explicitly because aliased providers are configured: aws.production, google.shared`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 11, Column: 1},
+						End:      hcl.Pos{Line: 11, Column: 17},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleExplicitProvidersRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
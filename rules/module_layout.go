@@ -0,0 +1,179 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/internal/layout"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// moduleLayoutRuleConfig is the config structure for the module_layout rule
+type moduleLayoutRuleConfig struct {
+	Profiles []moduleLayoutProfileConfig `hclext:"profile,block"`
+}
+
+// moduleLayoutProfileConfig is a profile block of the module_layout rule
+type moduleLayoutProfileConfig struct {
+	Name           string   `hclext:"name,label"`
+	Directories    []string `hclext:"directories"`
+	RequiredFiles  []string `hclext:"required_files,optional"`
+	ForbiddenFiles []string `hclext:"forbidden_files,optional"`
+}
+
+// ModuleLayoutRule checks the directories of a repository against configurable layout profiles
+type ModuleLayoutRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleLayoutRule returns a new rule
+func NewModuleLayoutRule() *ModuleLayoutRule {
+	return &ModuleLayoutRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleLayoutRule) Name() string {
+	return "module_layout"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleLayoutRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleLayoutRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModuleLayoutRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleLayoutRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Enforce required and forbidden files for classes of directories, such as root and reusable modules",
+		Tags:        []string{TagStructure},
+		Config: []ConfigOption{
+			{Name: "profile", Description: "Labelled block classifying directories and listing their files. The first profile whose directories match a directory applies to it", Default: ""},
+			{Name: "profile.directories", Description: "Glob patterns of the directories in the profile, relative to the root of the git repository, or to the working directory outside one", Default: ""},
+			{Name: "profile.required_files", Description: "File name patterns each directory must match at least once", Default: "[]"},
+			{Name: "profile.forbidden_files", Description: "File name patterns no file of a directory may match", Default: "[]"},
+		},
+		Example: `
+# .tflint.hcl, linted with tflint --recursive so every directory is inspected.
+# Directories are matched relative to the root of the git repository.
+rule "module_layout" {
+  enabled = true
+
+  profile "root" {
+    directories     = ["environments/*"]
+    required_files  = ["main.tf", "backend.tf"]
+    forbidden_files = ["outputs.tf"]
+  }
+
+  profile "module" {
+    directories     = ["modules/*"]
+    required_files  = ["main.tf", "variables.tf", "outputs.tf", "README.md"]
+    forbidden_files = ["backend.tf", "*.tfvars"]
+  }
+}
+`,
+	}
+}
+
+// Check emits issues when the inspected directory is missing a required file or includes a forbidden one.
+// Only the inspected directory is evaluated, classified by its path in the git repository, so monorepos are
+// linted with tflint --recursive and each directory is reported once.
+func (r *ModuleLayoutRule) Check(runner tflint.Runner) error {
+	config := &moduleLayoutRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if len(config.Profiles) == 0 {
+		return nil
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// TFLint drops issues in called modules that are not tied to module arguments,
+		// so directories are evaluated when they are linted themselves.
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	policy, err := r.policy(config.Profiles)
+	if err != nil {
+		return err
+	}
+	rel, err := repositoryPath(dir)
+	if err != nil {
+		return err
+	}
+	violations, err := policy.Evaluate(dir, rel)
+	if err != nil {
+		return err
+	}
+
+	for _, violation := range violations {
+		message := fmt.Sprintf("%s directory %s should include %s", violation.Profile, rel, violation.File)
+		if violation.Forbidden {
+			message = fmt.Sprintf("%s directory %s should not include %s", violation.Profile, rel, violation.File)
+		}
+		if err := runner.EmitIssue(
+			r,
+			message,
+			hcl.Range{
+				Filename: filepath.Join(dir, violation.File),
+				Start:    hcl.InitialPos,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ModuleLayoutRule) policy(configs []moduleLayoutProfileConfig) (*layout.Policy, error) {
+	seen := map[string]bool{}
+	profiles := make([]layout.Profile, 0, len(configs))
+	for _, config := range configs {
+		if seen[config.Name] {
+			return nil, fmt.Errorf("profile %q is declared more than once", config.Name)
+		}
+		seen[config.Name] = true
+
+		directories := make([]*regexp.Regexp, 0, len(config.Directories))
+		for _, glob := range config.Directories {
+			pattern, err := compileGlob(glob)
+			if err != nil {
+				return nil, fmt.Errorf("invalid directory pattern %q in profile %q: %w", glob, config.Name, err)
+			}
+			directories = append(directories, pattern)
+		}
+
+		profiles = append(profiles, layout.Profile{
+			Name:           config.Name,
+			Directories:    directories,
+			RequiredFiles:  config.RequiredFiles,
+			ForbiddenFiles: config.ForbiddenFiles,
+		})
+	}
+	return layout.New(profiles)
+}
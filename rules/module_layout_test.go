@@ -0,0 +1,188 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleLayoutRule(t *testing.T) {
+	profiles := `
+rule "module_layout" {
+  enabled = true
+
+  profile "root" {
+    directories     = [".", "environments/*"]
+    required_files  = ["main.tf", "backend.tf"]
+    forbidden_files = ["outputs.tf"]
+  }
+
+  profile "module" {
+    directories     = ["modules/*"]
+    required_files  = ["main.tf", "README.md"]
+    forbidden_files = ["backend.tf", "*.tfvars"]
+  }
+}
+`
+
+	cases := []struct {
+		Name     string
+		Config   string
+		Dir      string
+		Files    map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name:     "no profiles",
+			Dir:      "modules/network",
+			Files:    map[string]string{"modules/network/backend.tf": ""},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name:   "compliant directory",
+			Config: profiles,
+			Dir:    "environments/prod",
+			Files: map[string]string{
+				"environments/prod/backend.tf": "",
+				// Other directories are evaluated when TFLint inspects them
+				"modules/network/backend.tf": "",
+			},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name:     "unclassified directory",
+			Config:   profiles,
+			Dir:      "examples/basic",
+			Files:    map[string]string{"examples/basic/outputs.tf": ""},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name:   "repository root",
+			Config: profiles,
+			Dir:    ".",
+			Files: map[string]string{
+				"backend.tf": "",
+				"outputs.tf": "",
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleLayoutRule(),
+						Message: "root directory . should not include outputs.tf",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "outputs.tf"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+		{
+			Name:   "violations",
+			Config: profiles,
+			Dir:    "modules/network",
+			Files: map[string]string{
+				"modules/network/backend.tf":     "",
+				"modules/network/test.tfvars":    "",
+				"modules/network/docs/README.md": "",
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleLayoutRule(),
+						Message: "module directory modules/network should include README.md",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "README.md"),
+							Start:    hcl.InitialPos,
+						},
+					},
+					{
+						Rule:    NewModuleLayoutRule(),
+						Message: "module directory modules/network should not include backend.tf",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "backend.tf"),
+							Start:    hcl.InitialPos,
+						},
+					},
+					{
+						Rule:    NewModuleLayoutRule(),
+						Message: "module directory modules/network should not include test.tfvars",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "test.tfvars"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "first matching profile applies",
+			Config: `
+rule "module_layout" {
+  enabled = true
+
+  profile "shared" {
+    directories    = ["modules/shared/*"]
+    required_files = ["main.tf"]
+  }
+
+  profile "module" {
+    directories    = ["modules/**"]
+    required_files = ["README.md"]
+  }
+}
+`,
+			Dir:      "modules/shared/labels",
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+	}
+
+	rule := NewModuleLayoutRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			root := t.TempDir()
+			dir := filepath.Join(root, tc.Dir)
+			writeFiles(t, root, tc.Files)
+			// The layout is read from disk, so the inspected module must exist there too
+			writeFiles(t, root, map[string]string{".git/HEAD": "", filepath.Join(tc.Dir, "main.tf"): ""})
+
+			content := map[string]string{filepath.Join(dir, "main.tf"): ""}
+			if tc.Config != "" {
+				content[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
+
+func Test_ModuleLayoutRule_DuplicateProfile(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"main.tf": "",
+		".tflint.hcl": `
+rule "module_layout" {
+  enabled = true
+
+  profile "module" {
+    directories = ["modules/*"]
+  }
+
+  profile "module" {
+    directories = ["stacks/*"]
+  }
+}
+`,
+	})
+
+	if err := NewModuleLayoutRule().Check(runner); err == nil {
+		t.Fatal("Expected an error for a duplicate profile")
+	}
+}
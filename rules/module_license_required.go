@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// moduleLicenseRequiredRuleConfig is the config structure for the module_license_required rule
+type moduleLicenseRequiredRuleConfig struct {
+	Filenames []string `hclext:"filenames,optional"`
+}
+
+// ModuleLicenseRequiredRule checks whether a module includes a license file
+type ModuleLicenseRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleLicenseRequiredRule returns a new rule
+func NewModuleLicenseRequiredRule() *ModuleLicenseRequiredRule {
+	return &ModuleLicenseRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleLicenseRequiredRule) Name() string {
+	return "module_license_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleLicenseRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleLicenseRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModuleLicenseRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleLicenseRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require a LICENSE file in the module root",
+		Tags:        []string{TagDocumentation},
+		Config: []ConfigOption{
+			{Name: "filenames", Description: "Accepted license file names", Default: `["LICENSE", "LICENSE.md", "COPYING"]`},
+		},
+		Example: `
+# A module without a LICENSE file
+`,
+	}
+}
+
+// Check emits an issue when none of the accepted license files exists in the module root
+func (r *ModuleLicenseRequiredRule) Check(runner tflint.Runner) error {
+	config := &moduleLicenseRequiredRuleConfig{
+		Filenames: []string{"LICENSE", "LICENSE.md", "COPYING"},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 || len(config.Filenames) == 0 {
+		return nil
+	}
+
+	if anyFileExists(dir, config.Filenames) {
+		return nil
+	}
+
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf("Module should include a license file (%s)", strings.Join(config.Filenames, ", ")),
+		hcl.Range{
+			Filename: filepath.Join(dir, config.Filenames[0]),
+			Start:    hcl.InitialPos,
+		},
+	)
+}
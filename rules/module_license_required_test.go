@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleLicenseRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   string
+		Files    map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name:     "LICENSE present",
+			Files:    map[string]string{"LICENSE": "MIT"},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name:     "COPYING present",
+			Files:    map[string]string{"COPYING": "GPL"},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name:  "missing license",
+			Files: map[string]string{},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleLicenseRequiredRule(),
+						Message: "Module should include a license file (LICENSE, LICENSE.md, COPYING)",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "LICENSE"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "custom filenames",
+			Config: `
+rule "module_license_required" {
+  enabled   = true
+  filenames = ["LICENSE.txt"]
+}
+`,
+			Files: map[string]string{"LICENSE": "MIT"},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleLicenseRequiredRule(),
+						Message: "Module should include a license file (LICENSE.txt)",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "LICENSE.txt"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+	}
+
+	rule := NewModuleLicenseRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.Files)
+
+			content := map[string]string{filepath.Join(dir, "main.tf"): ""}
+			if tc.Config != "" {
+				content[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/internal/naming"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// moduleNamingConventionRuleConfig is the config structure for the module_naming_convention rule
+type moduleNamingConventionRuleConfig struct {
+	Format string `hclext:"format,optional"`
+	Custom string `hclext:"custom,optional"`
+	Prefix string `hclext:"prefix,optional"`
+	Suffix string `hclext:"suffix,optional"`
+}
+
+// ModuleNamingConventionRule checks whether module call names follow a naming convention
+type ModuleNamingConventionRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleNamingConventionRule returns a new rule
+func NewModuleNamingConventionRule() *ModuleNamingConventionRule {
+	return &ModuleNamingConventionRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleNamingConventionRule) Name() string {
+	return "module_naming_convention"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleNamingConventionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *ModuleNamingConventionRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *ModuleNamingConventionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleNamingConventionRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Enforce a naming convention on module call names",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "format", Description: "snake_case, camelCase, PascalCase, kebab-case or custom", Default: "snake_case"},
+			{Name: "custom", Description: "Regular expression used when format is custom", Default: ""},
+			{Name: "prefix", Description: "Prefix every module name must start with", Default: ""},
+			{Name: "suffix", Description: "Suffix every module name must end with", Default: ""},
+		},
+		Example: `
+module "NetworkStack" {
+  source = "./modules/network"
+}
+`,
+	}
+}
+
+// Check emits issues for module call names that do not match the configured format, prefix or suffix
+func (r *ModuleNamingConventionRule) Check(runner tflint.Runner) error {
+	format, custom := namingDefaults(runner)
+	config := &moduleNamingConventionRuleConfig{Format: format, Custom: custom}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	convention, err := naming.New(config.Format, config.Custom)
+	if err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "module",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, module := range body.Blocks {
+		name := module.Labels[0]
+
+		var message string
+		switch {
+		case !convention.Match(name):
+			message = fmt.Sprintf("module name %q must match the following %s", name, convention)
+		case !strings.HasPrefix(name, config.Prefix):
+			message = fmt.Sprintf("module name %q must start with %q", name, config.Prefix)
+		case !strings.HasSuffix(name, config.Suffix):
+			message = fmt.Sprintf("module name %q must end with %q", name, config.Suffix)
+		default:
+			continue
+		}
+
+		if err := runner.EmitIssue(r, message, module.DefRange); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
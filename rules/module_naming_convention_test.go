@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleNamingConventionRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "snake_case by default",
+			Content: map[string]string{
+				"main.tf": `
+module "my_thing" { source = "./modules/thing" }
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "invalid name",
+			Content: map[string]string{
+				"main.tf": `
+module "MyThing-2" { source = "./modules/thing" }
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModuleNamingConventionRule(),
+					Message: `module name "MyThing-2" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name: "prefix and suffix",
+			Content: map[string]string{
+				"main.tf": `
+module "mod_thing_call" { source = "./modules/thing" }
+module "thing_call" { source = "./modules/thing" }
+module "mod_thing" { source = "./modules/thing" }
+`,
+				".tflint.hcl": `
+rule "module_naming_convention" {
+  enabled = true
+  prefix  = "mod_"
+  suffix  = "_call"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModuleNamingConventionRule(),
+					Message: `module name "thing_call" must start with "mod_"`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 20},
+					},
+				},
+				{
+					Rule:    NewModuleNamingConventionRule(),
+					Message: `module name "mod_thing" must end with "_call"`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 19},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleNamingConventionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
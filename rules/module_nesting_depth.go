@@ -0,0 +1,175 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// moduleNestingDepthRuleConfig is the config structure for the module_nesting_depth rule
+type moduleNestingDepthRuleConfig struct {
+	MaxDepth int `hclext:"max_depth,optional"`
+}
+
+// ModuleNestingDepthRule checks how deeply local module calls are nested
+type ModuleNestingDepthRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleNestingDepthRule returns a new rule
+func NewModuleNestingDepthRule() *ModuleNestingDepthRule {
+	return &ModuleNestingDepthRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleNestingDepthRule) Name() string {
+	return "module_nesting_depth"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleNestingDepthRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *ModuleNestingDepthRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModuleNestingDepthRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleNestingDepthRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Limit how deeply local module calls are nested",
+		Tags:        []string{TagStructure},
+		Config: []ConfigOption{
+			{Name: "max_depth", Description: "Maximum depth of nested local module calls", Default: "2"},
+		},
+		Example: `
+# ./modules/a calls ./modules/b, which calls ./modules/c
+module "a" {
+  source = "./modules/a"
+}
+`,
+	}
+}
+
+// Check follows local module sources from the root module and emits an issue for each module call
+// whose chain of local module calls is deeper than max_depth
+func (r *ModuleNestingDepthRule) Check(runner tflint.Runner) error {
+	config := &moduleNestingDepthRuleConfig{MaxDepth: 2}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "module",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "source"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, module := range body.Blocks {
+		attr, exists := module.Body.Attributes["source"]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(source string) error {
+			if !isLocalSource(source) {
+				return nil
+			}
+
+			absDir, err := filepath.Abs(filepath.Join(dir, source))
+			if err != nil {
+				return err
+			}
+			chain, err := deepestModuleChain(absDir, map[string]bool{})
+			if err != nil {
+				return err
+			}
+			chain = append([]string{module.Labels[0]}, chain...)
+			if len(chain) <= config.MaxDepth {
+				return nil
+			}
+
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf(
+					"module %q nests local modules %d levels deep (%s), the maximum is %d",
+					module.Labels[0],
+					len(chain),
+					strings.Join(chain, " > "),
+					config.MaxDepth,
+				),
+				module.DefRange,
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deepestModuleChain returns the names of the longest chain of local module calls starting in dir.
+// Directories already on the current path are skipped to guard against cycles.
+func deepestModuleChain(dir string, visiting map[string]bool) ([]string, error) {
+	if visiting[dir] {
+		return nil, nil
+	}
+	visiting[dir] = true
+	defer delete(visiting, dir)
+
+	calls, err := localModuleCalls(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	deepest := []string{}
+	for _, call := range calls {
+		chain, err := deepestModuleChain(filepath.Join(dir, call.source), visiting)
+		if err != nil {
+			return nil, err
+		}
+		if len(chain)+1 > len(deepest) {
+			deepest = append([]string{call.name}, chain...)
+		}
+	}
+	return deepest, nil
+}
@@ -0,0 +1,123 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleNestingDepthRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   string
+		Content  string
+		Dirs     map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name: "shallow nesting",
+			Content: `
+module "app" { source = "./modules/app" }
+module "vpc" { source = "terraform-aws-modules/vpc/aws" }
+`,
+			Dirs: map[string]string{
+				"modules/app/main.tf":     `module "db" { source = "../db" }`,
+				"modules/db/main.tf":      `module "remote" { source = "terraform-aws-modules/rds/aws" }`,
+				"modules/unused/main.tf":  `module "x" { source = "../app" }`,
+				"modules/unused/other.md": "",
+			},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "deep nesting",
+			Content: `
+module "app" { source = "./modules/app" }
+`,
+			Dirs: map[string]string{
+				"modules/app/main.tf":             `module "network" { source = "../network" }`,
+				"modules/network/main.tf":         `module "subnets" { source = "./subnets" }`,
+				"modules/network/subnets/main.tf": "",
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleNestingDepthRule(),
+						Message: `module "app" nests local modules 3 levels deep (app > network > subnets), the maximum is 2`,
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "main.tf"),
+							Start:    hcl.Pos{Line: 2, Column: 1},
+							End:      hcl.Pos{Line: 2, Column: 13},
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "JSON and unreadable modules",
+			Content: `
+module "app" { source = "./modules/app" }
+module "broken" { source = "./modules/broken" }
+`,
+			Dirs: map[string]string{
+				"modules/app/main.tf.json":        `{"module": {"network": {"source": "../network"}}}`,
+				"modules/network/main.tf":         `module "subnets" { source = "./subnets" }`,
+				"modules/network/subnets/main.tf": "",
+				"modules/broken/main.tf":          `module "x" {`,
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleNestingDepthRule(),
+						Message: `module "app" nests local modules 3 levels deep (app > network > subnets), the maximum is 2`,
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "main.tf"),
+							Start:    hcl.Pos{Line: 2, Column: 1},
+							End:      hcl.Pos{Line: 2, Column: 13},
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "custom max depth",
+			Config: `
+rule "module_nesting_depth" {
+  enabled   = true
+  max_depth = 3
+}
+`,
+			Content: `
+module "app" { source = "./modules/app" }
+`,
+			Dirs: map[string]string{
+				"modules/app/main.tf":             `module "network" { source = "../network" }`,
+				"modules/network/main.tf":         `module "subnets" { source = "./subnets" }`,
+				"modules/network/subnets/main.tf": "",
+			},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+	}
+
+	rule := NewModuleNestingDepthRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.Dirs)
+
+			content := map[string]string{filepath.Join(dir, "main.tf"): tc.Content}
+			if tc.Config != "" {
+				content[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package rules
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var (
+	// registrySourcePattern matches <NAMESPACE>/<NAME>/<PROVIDER> with an optional <HOSTNAME>/ prefix
+	registrySourcePattern = regexp.MustCompile(`^([0-9A-Za-z.-]+/)?[0-9A-Za-z_-]+/[0-9A-Za-z_-]+/[0-9A-Za-z]+(//.*)?$`)
+	versionRefPattern     = regexp.MustCompile(`^v?\d+(\.\d+)*([-+][0-9A-Za-z.-]+)?$`)
+	commitRefPattern      = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
+	fullCommitRefPattern  = regexp.MustCompile(`^[0-9a-f]{40}$`)
+)
+
+// modulePinnedSourceRuleConfig is the config structure for the module_pinned_source rule
+type modulePinnedSourceRuleConfig struct {
+	AllowBranchRefs  bool `hclext:"allow_branch_refs,optional"`
+	RequireCommitSHA bool `hclext:"require_commit_sha,optional"`
+}
+
+// ModulePinnedSourceRule checks whether module sources pin a version
+type ModulePinnedSourceRule struct {
+	tflint.DefaultRule
+}
+
+// NewModulePinnedSourceRule returns a new rule
+func NewModulePinnedSourceRule() *ModulePinnedSourceRule {
+	return &ModulePinnedSourceRule{}
+}
+
+// Name returns the rule name
+func (r *ModulePinnedSourceRule) Name() string {
+	return "module_pinned_source"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModulePinnedSourceRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *ModulePinnedSourceRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModulePinnedSourceRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModulePinnedSourceRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require module sources to pin a version, tag or commit",
+		Tags:        []string{TagSecurity},
+		Config: []ConfigOption{
+			{Name: "allow_branch_refs", Description: "Accept Git refs that are branch names", Default: "false"},
+			{Name: "require_commit_sha", Description: "Only accept full 40-character commit SHAs as Git refs, since tags can be moved", Default: "false"},
+		},
+		Example: `
+module "network" {
+  source = "git::https://example.com/network.git"
+}
+`,
+	}
+}
+
+// Check emits issues for registry sources without a version and git sources without a tag or commit ref.
+// With require_commit_sha, git sources must pin a full commit SHA.
+func (r *ModulePinnedSourceRule) Check(runner tflint.Runner) error {
+	config := &modulePinnedSourceRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "module",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "source"}, {Name: "version"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, module := range body.Blocks {
+		attr, exists := module.Body.Attributes["source"]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(source string) error {
+			switch {
+			case isGitSource(source):
+				ref, err := gitRef(source)
+				if err != nil {
+					return err
+				}
+				if ref == "" {
+					return runner.EmitIssue(
+						r,
+						fmt.Sprintf("module %q source should pin a ref (e.g. ?ref=v1.2.0)", module.Labels[0]),
+						attr.Range,
+					)
+				}
+				if config.RequireCommitSHA && !fullCommitRefPattern.MatchString(ref) {
+					return runner.EmitIssue(
+						r,
+						fmt.Sprintf("module %q source ref %q is not a full commit SHA, pin a 40-character commit SHA for immutability", module.Labels[0], ref),
+						attr.Range,
+					)
+				}
+				if !config.AllowBranchRefs && !isTagOrCommitRef(ref) {
+					return runner.EmitIssue(
+						r,
+						fmt.Sprintf("module %q source ref %q looks like a branch, pin a tag or commit SHA instead", module.Labels[0], ref),
+						attr.Range,
+					)
+				}
+			case isRegistrySource(source):
+				if _, exists := module.Body.Attributes["version"]; !exists {
+					return runner.EmitIssue(
+						r,
+						fmt.Sprintf("module %q should specify a version for registry source %q", module.Labels[0], source),
+						module.DefRange,
+					)
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isLocalSource returns whether the module source is a local path
+func isLocalSource(source string) bool {
+	return strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../")
+}
+
+// isGitSource returns whether the module source is fetched with git
+func isGitSource(source string) bool {
+	for _, prefix := range []string{"git::", "github.com/", "git@", "bitbucket.org/"} {
+		if strings.HasPrefix(source, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRegistrySource returns whether the module source is a module registry address
+func isRegistrySource(source string) bool {
+	return !isLocalSource(source) && !isGitSource(source) && !strings.Contains(source, "::") && registrySourcePattern.MatchString(source)
+}
+
+// gitRef returns the value of the ref query argument in a git module source
+func gitRef(source string) (string, error) {
+	_, query, found := strings.Cut(source, "?")
+	if !found {
+		return "", nil
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse module source %q: %w", source, err)
+	}
+	return values.Get("ref"), nil
+}
+
+// isTagOrCommitRef returns whether the ref looks like a version tag or a commit SHA rather than a branch
+func isTagOrCommitRef(ref string) bool {
+	return versionRefPattern.MatchString(ref) || commitRefPattern.MatchString(ref)
+}
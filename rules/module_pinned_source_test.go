@@ -0,0 +1,162 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModulePinnedSourceRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "pinned sources",
+			Content: map[string]string{
+				"main.tf": `
+module "local" {
+  source = "./modules/local"
+}
+module "registry" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.0.0"
+}
+module "tag" {
+  source = "git::https://example.com/vpc.git?ref=v1.2.0"
+}
+module "sha" {
+  source = "github.com/acme/vpc?ref=51d462976d84fdea54b47d80dcabbf680badcdb8"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "registry source without version",
+			Content: map[string]string{
+				"main.tf": `
+module "registry" {
+  source = "terraform-aws-modules/vpc/aws"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModulePinnedSourceRule(),
+					Message: `module "registry" should specify a version for registry source "terraform-aws-modules/vpc/aws"`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "git source without ref",
+			Content: map[string]string{
+				"main.tf": `
+module "git" {
+  source = "git::https://example.com/vpc.git"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModulePinnedSourceRule(),
+					Message: `module "git" source should pin a ref (e.g. ?ref=v1.2.0)`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "git source with branch ref",
+			Content: map[string]string{
+				"main.tf": `
+module "git" {
+  source = "git::https://example.com/vpc.git?ref=main"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModulePinnedSourceRule(),
+					Message: `module "git" source ref "main" looks like a branch, pin a tag or commit SHA instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 55},
+					},
+				},
+			},
+		},
+		{
+			Name: "branch refs allowed",
+			Content: map[string]string{
+				"main.tf": `
+module "git" {
+  source = "git::https://example.com/vpc.git?ref=main"
+}
+`,
+				".tflint.hcl": `
+rule "module_pinned_source" {
+  enabled           = true
+  allow_branch_refs = true
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "commit SHA required",
+			Content: map[string]string{
+				"main.tf": `
+module "tag" {
+  source = "git::https://example.com/vpc.git?ref=v1.2.0"
+}
+module "sha" {
+  source = "git::https://example.com/vpc.git?ref=0123456789abcdef0123456789abcdef01234567"
+}
+`,
+				".tflint.hcl": `
+rule "module_pinned_source" {
+  enabled            = true
+  require_commit_sha = true
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModulePinnedSourceRule(),
+					Message: `module "tag" source ref "v1.2.0" is not a full commit SHA, pin a 40-character commit SHA for immutability`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 57},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModulePinnedSourceRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,338 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// terraformDocsAnchorPattern matches the anchors terraform-docs puts in the name column, e.g. <a name="input_region"></a>
+var terraformDocsAnchorPattern = regexp.MustCompile(`<a name="(input|output)_([^"]+)">`)
+
+// terraformDocsEscapePattern matches the characters terraform-docs escapes in table cells
+var terraformDocsEscapePattern = regexp.MustCompile(`\\([\\|_*\x60<>\[\]])`)
+
+// ModuleReadmeDocsDriftRule checks whether the terraform-docs section of the README matches the variables and outputs
+type ModuleReadmeDocsDriftRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleReadmeDocsDriftRule returns a new rule
+func NewModuleReadmeDocsDriftRule() *ModuleReadmeDocsDriftRule {
+	return &ModuleReadmeDocsDriftRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleReadmeDocsDriftRule) Name() string {
+	return "module_readme_docs_drift"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleReadmeDocsDriftRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleReadmeDocsDriftRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModuleReadmeDocsDriftRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleReadmeDocsDriftRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require the terraform-docs inputs and outputs tables in README.md to match the variables and outputs",
+		Tags:        []string{TagDocumentation},
+		Example: `
+variable "region" {
+  description = "AWS region to deploy to"
+  type        = string
+}
+# README.md still documents region as "Region"
+`,
+	}
+}
+
+// docsEntry is an input or output as terraform-docs renders it
+type docsEntry struct {
+	description string
+	required    string
+	// line is the line of the table row in the README, or 0 for entries generated from the module
+	line int
+}
+
+// Check emits issues for differences between the variables and outputs of the module and the Inputs and Outputs
+// tables between terraform-docs markers in README.md: entries missing from either side, descriptions that differ,
+// and inputs whose Required column is wrong. READMEs without terraform-docs markers are left to module_readme_documented.
+func (r *ModuleReadmeDocsDriftRule) Check(runner tflint.Runner) error {
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	// README.md is not served by the runner, so it is read from the filesystem
+	readmePath := filepath.Join(dir, filenameReadme)
+	src, err := os.ReadFile(readmePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	documented, ok := terraformDocsTables(string(src))
+	if !ok {
+		return nil
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "description"}, {Name: "default"}},
+				},
+			},
+			{
+				Type:       "output",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "description"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	declared := map[string]bool{}
+	for _, block := range body.Blocks {
+		kind := "input"
+		if block.Type == "output" {
+			kind = "output"
+		}
+		name := block.Labels[0]
+		declared[kind+"."+name] = true
+
+		entry, exists := documented[kind][name]
+		if !exists {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s %q is missing from the terraform-docs %ss in %s, regenerate the docs", block.Type, name, kind, filenameReadme),
+				block.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if attr, exists := block.Body.Attributes["description"]; exists {
+			if description, ok := literalDescription(attr); ok && normalizeDocsCell(description) != entry.description {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("description of %s %q differs from the terraform-docs %ss in %s, regenerate the docs", block.Type, name, kind, filenameReadme),
+					attr.Range,
+				); err != nil {
+					return err
+				}
+			}
+		} else if entry.description != "" {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s %q has no description but the terraform-docs %ss in %s describe it, regenerate the docs", block.Type, name, kind, filenameReadme),
+				block.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+
+		if kind == "input" && entry.required != "" {
+			required, state := "yes", "required"
+			if _, exists := block.Body.Attributes["default"]; exists {
+				required, state = "no", "optional"
+			}
+			if required != entry.required {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("variable %q is %s but the terraform-docs inputs in %s say Required = %s, regenerate the docs", name, state, filenameReadme, entry.required),
+					block.DefRange,
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, kind := range []string{"input", "output"} {
+		names := make([]string, 0, len(documented[kind]))
+		for name := range documented[kind] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if declared[kind+"."+name] {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s %q is documented in %s but not declared, regenerate the docs", kind, name, filenameReadme),
+				hcl.Range{
+					Filename: readmePath,
+					Start:    hcl.Pos{Line: documented[kind][name].line, Column: 1},
+				},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// terraformDocsTables returns the rows of the Inputs and Outputs tables between terraform-docs markers,
+// keyed by "input" or "output" and then by name. It returns false if the README has no terraform-docs section.
+func terraformDocsTables(readme string) (map[string]map[string]docsEntry, bool) {
+	begin, end := -1, -1
+	for _, markers := range terraformDocsMarkers {
+		begin = strings.Index(readme, markers[0])
+		end = strings.Index(readme, markers[1])
+		if begin >= 0 && end > begin {
+			break
+		}
+	}
+	if begin < 0 || end <= begin {
+		return nil, false
+	}
+
+	tables := map[string]map[string]docsEntry{"input": {}, "output": {}}
+	offset := strings.Count(readme[:begin], "\n")
+	section := ""
+	var columns map[string]int
+	for i, line := range strings.Split(readme[begin:end], "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			switch strings.ToLower(strings.TrimSpace(strings.TrimLeft(line, "#"))) {
+			case "inputs":
+				section = "input"
+			case "outputs":
+				section = "output"
+			default:
+				section = ""
+			}
+			columns = nil
+			continue
+		}
+		if section == "" || !strings.HasPrefix(line, "|") {
+			continue
+		}
+
+		cells := markdownCells(line)
+		if columns == nil {
+			columns = map[string]int{}
+			for j, cell := range cells {
+				columns[strings.ToLower(cell)] = j
+			}
+			continue
+		}
+		if len(cells) == 0 || strings.HasPrefix(strings.Trim(cells[0], ":"), "---") {
+			continue
+		}
+
+		j, exists := columns["name"]
+		if !exists || j >= len(cells) {
+			// Rows shorter than the header, and tables without a Name column, are not terraform-docs output
+			continue
+		}
+		name := docsName(cells[j])
+		if name == "" {
+			continue
+		}
+		entry := docsEntry{line: offset + i + 1}
+		if j, exists := columns["description"]; exists && j < len(cells) {
+			entry.description = normalizeDocsCell(cells[j])
+			if entry.description == "n/a" {
+				entry.description = ""
+			}
+		}
+		if j, exists := columns["required"]; exists && j < len(cells) {
+			entry.required = strings.ToLower(cells[j])
+		}
+		tables[section][name] = entry
+	}
+
+	return tables, true
+}
+
+// markdownCells splits a table row into trimmed cells, ignoring pipes escaped with a backslash
+func markdownCells(row string) []string {
+	row = strings.TrimSuffix(strings.TrimPrefix(row, "|"), "|")
+	cells := []string{}
+	var cell strings.Builder
+	for i := 0; i < len(row); i++ {
+		if row[i] == '\\' && i+1 < len(row) && row[i+1] == '|' {
+			cell.WriteString(`\|`)
+			i++
+			continue
+		}
+		if row[i] == '|' {
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+			continue
+		}
+		cell.WriteByte(row[i])
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// docsName returns the input or output name in the name column, from its anchor or from the plain text
+func docsName(cell string) string {
+	if match := terraformDocsAnchorPattern.FindStringSubmatch(cell); match != nil {
+		return match[2]
+	}
+	return normalizeDocsCell(strings.Trim(cell, "`"))
+}
+
+// normalizeDocsCell undoes the escaping and line breaks terraform-docs applies to table cells
+func normalizeDocsCell(text string) string {
+	text = strings.ReplaceAll(text, "<br>", " ")
+	text = terraformDocsEscapePattern.ReplaceAllString(text, "$1")
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// literalDescription returns the description when it is a literal string
+func literalDescription(attr *hclext.Attribute) (string, bool) {
+	val, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() || val.Type() != cty.String || val.IsNull() || !val.IsKnown() {
+		return "", false
+	}
+	return val.AsString(), true
+}
@@ -0,0 +1,11 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/jforde/tflint-ruleset-hackathon/internal/ruletest"
+)
+
+func Test_ModuleReadmeDocsDriftRule(t *testing.T) {
+	ruletest.Run(t, NewModuleReadmeDocsDriftRule(), "testdata/module_readme_docs_drift")
+}
@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// terraformDocsMarkers are the begin/end comment pairs that terraform-docs injects into READMEs
+var terraformDocsMarkers = [][2]string{
+	{"<!-- BEGIN_TF_DOCS -->", "<!-- END_TF_DOCS -->"},
+	{"<!-- BEGINNING OF PRE-COMMIT-TERRAFORM DOCS HOOK -->", "<!-- END OF PRE-COMMIT-TERRAFORM DOCS HOOK -->"},
+}
+
+// ModuleReadmeDocumentedRule checks whether the README documents every variable and output
+type ModuleReadmeDocumentedRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleReadmeDocumentedRule returns a new rule
+func NewModuleReadmeDocumentedRule() *ModuleReadmeDocumentedRule {
+	return &ModuleReadmeDocumentedRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleReadmeDocumentedRule) Name() string {
+	return "module_readme_documented"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleReadmeDocumentedRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleReadmeDocumentedRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModuleReadmeDocumentedRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleReadmeDocumentedRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require README.md to document every variable and output",
+		Tags:        []string{TagDocumentation},
+		Example: `
+variable "region" {}
+# README.md does not mention region
+`,
+	}
+}
+
+// Check emits issues for variables and outputs that are not mentioned in README.md.
+// READMEs generated by terraform-docs are trusted as is. A missing README is left to standard_module_structure.
+func (r *ModuleReadmeDocumentedRule) Check(runner tflint.Runner) error {
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	// README.md is not served by the runner, so it is read from the filesystem
+	src, err := os.ReadFile(filepath.Join(dir, filenameReadme))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	readme := string(src)
+
+	for _, markers := range terraformDocsMarkers {
+		if strings.Contains(readme, markers[0]) && strings.Contains(readme, markers[1]) {
+			return nil
+		}
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "variable", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}},
+			{Type: "output", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, block := range body.Blocks {
+		name := block.Labels[0]
+		mentioned := regexp.MustCompile(`(^|[^\w-])` + regexp.QuoteMeta(name) + `($|[^\w-])`)
+		if mentioned.MatchString(readme) {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s %q is not documented in %s", block.Type, name, filenameReadme),
+			block.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
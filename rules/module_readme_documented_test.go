@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleReadmeDocumentedRule(t *testing.T) {
+	module := `
+variable "instance_type" {}
+variable "name" {}
+output "instance_id" { value = null }
+`
+
+	cases := []struct {
+		Name     string
+		Files    map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name:     "missing README",
+			Files:    map[string]string{},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "everything documented",
+			Files: map[string]string{
+				"README.md": "| `instance_type` | EC2 type |\n| `name` | Name |\n\n## Outputs\n\n- instance_id\n",
+			},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "terraform-docs markers",
+			Files: map[string]string{
+				"README.md": "# Module\n<!-- BEGIN_TF_DOCS -->\n<!-- END_TF_DOCS -->\n",
+			},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "undocumented inputs and outputs",
+			Files: map[string]string{
+				"README.md": "# Module\n\nSet `instance_type_override` and `name`.\n",
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleReadmeDocumentedRule(),
+						Message: `variable "instance_type" is not documented in README.md`,
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "main.tf"),
+							Start:    hcl.Pos{Line: 2, Column: 1},
+							End:      hcl.Pos{Line: 2, Column: 25},
+						},
+					},
+					{
+						Rule:    NewModuleReadmeDocumentedRule(),
+						Message: `output "instance_id" is not documented in README.md`,
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "main.tf"),
+							Start:    hcl.Pos{Line: 4, Column: 1},
+							End:      hcl.Pos{Line: 4, Column: 21},
+						},
+					},
+				}
+			},
+		},
+	}
+
+	rule := NewModuleReadmeDocumentedRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.Files)
+
+			runner := helper.TestRunner(t, map[string]string{filepath.Join(dir, "main.tf"): module})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
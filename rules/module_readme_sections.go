@@ -0,0 +1,180 @@
+package rules
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// moduleReadmeSectionsRuleConfig is the config structure for the module_readme_sections rule
+type moduleReadmeSectionsRuleConfig struct {
+	Sections []string `hclext:"sections,optional"`
+}
+
+// ModuleReadmeSectionsRule checks whether the README contains the required sections
+type ModuleReadmeSectionsRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleReadmeSectionsRule returns a new rule
+func NewModuleReadmeSectionsRule() *ModuleReadmeSectionsRule {
+	return &ModuleReadmeSectionsRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleReadmeSectionsRule) Name() string {
+	return "module_readme_sections"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleReadmeSectionsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleReadmeSectionsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModuleReadmeSectionsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleReadmeSectionsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require README.md to contain the configured section headings",
+		Tags:        []string{TagDocumentation},
+		Config: []ConfigOption{
+			{Name: "sections", Description: "Headings that must appear in README.md, compared case-insensitively", Default: `["Usage", "Requirements", "Inputs", "Outputs"]`},
+		},
+		Example: `
+# README.md without a "## Usage" heading
+`,
+	}
+}
+
+// Check emits an issue for every required section missing from README.md.
+// A missing README is left to standard_module_structure.
+func (r *ModuleReadmeSectionsRule) Check(runner tflint.Runner) error {
+	config := &moduleReadmeSectionsRuleConfig{
+		Sections: []string{"Usage", "Requirements", "Inputs", "Outputs"},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	// README.md is not served by the runner, so it is read from the filesystem
+	filename := filepath.Join(dir, filenameReadme)
+	src, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	headings := map[string]bool{}
+	for _, heading := range markdownHeadings(string(src)) {
+		headings[strings.ToLower(heading.Text)] = true
+	}
+
+	for _, section := range config.Sections {
+		if headings[strings.ToLower(section)] {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s should include a %q heading", filenameReadme, section),
+			hcl.Range{
+				Filename: filename,
+				Start:    hcl.InitialPos,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// markdownHeading is a heading of a markdown document
+type markdownHeading struct {
+	Text  string
+	Level int
+	// Line is the 1-based line the heading text is on
+	Line int
+}
+
+// markdownHeadings returns the ATX and setext headings of a markdown document, skipping fenced code blocks
+func markdownHeadings(src string) []markdownHeading {
+	var headings []markdownHeading
+	var fence, previous string
+
+	scanner := bufio.NewScanner(strings.NewReader(src))
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if fence != "" {
+			if strings.HasPrefix(line, fence) {
+				fence = ""
+			}
+			previous = ""
+			continue
+		}
+		if strings.HasPrefix(line, "```") || strings.HasPrefix(line, "~~~") {
+			fence = line[:3]
+			previous = ""
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "#"):
+			level := len(line) - len(strings.TrimLeft(line, "#"))
+			text := line[level:]
+			if level <= 6 && (text == "" || text[0] == ' ' || text[0] == '\t') {
+				headings = append(headings, markdownHeading{
+					Text:  strings.TrimSpace(strings.TrimRight(strings.TrimSpace(text), "#")),
+					Level: level,
+					Line:  number,
+				})
+				line = ""
+			}
+		case previous != "" && line != "" && strings.Trim(line, "=") == "":
+			headings = append(headings, markdownHeading{Text: previous, Level: 1, Line: number - 1})
+			line = ""
+		case previous != "" && line != "" && strings.Trim(line, "-") == "":
+			headings = append(headings, markdownHeading{Text: previous, Level: 2, Line: number - 1})
+			line = ""
+		}
+		previous = line
+	}
+
+	return headings
+}
@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/internal/ruletest"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleReadmeSectionsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   string
+		Files    map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name:     "missing README",
+			Files:    map[string]string{},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "all sections",
+			Files: map[string]string{"README.md": `
+Module
+======
+
+## Usage
+
+### requirements ###
+
+Inputs
+------
+
+## Outputs
+`},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name:  "missing sections",
+			Files: map[string]string{"README.md": "# Module\n\n## Usage\n\n```markdown\n## Inputs\n```\n\n#Outputs\n\n## Requirements\n"},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleReadmeSectionsRule(),
+						Message: `README.md should include a "Inputs" heading`,
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "README.md"),
+							Start:    hcl.InitialPos,
+						},
+					},
+					{
+						Rule:    NewModuleReadmeSectionsRule(),
+						Message: `README.md should include a "Outputs" heading`,
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "README.md"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "custom sections",
+			Config: `
+rule "module_readme_sections" {
+  enabled  = true
+  sections = ["Examples"]
+}
+`,
+			Files:    map[string]string{"README.md": "# Module\n\n## Examples\n"},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+	}
+
+	rule := NewModuleReadmeSectionsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.Files)
+
+			content := map[string]string{filepath.Join(dir, "main.tf"): ""}
+			if tc.Config != "" {
+				content[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
+
+func Test_ModuleReadmeSectionsRule_Fixtures(t *testing.T) {
+	ruletest.Run(t, NewModuleReadmeSectionsRule(), "testdata/module_readme_sections")
+}
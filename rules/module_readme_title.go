@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// readmeTitleDirPlaceholder is replaced with the module directory name in the title option
+const readmeTitleDirPlaceholder = "{dir}"
+
+// moduleReadmeTitleRuleConfig is the config structure for the module_readme_title rule
+type moduleReadmeTitleRuleConfig struct {
+	Title string `hclext:"title,optional"`
+}
+
+// ModuleReadmeTitleRule checks whether the README title matches the module directory name
+type ModuleReadmeTitleRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleReadmeTitleRule returns a new rule
+func NewModuleReadmeTitleRule() *ModuleReadmeTitleRule {
+	return &ModuleReadmeTitleRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleReadmeTitleRule) Name() string {
+	return "module_readme_title"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleReadmeTitleRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleReadmeTitleRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *ModuleReadmeTitleRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleReadmeTitleRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require the first level 1 heading of README.md to match the module directory name",
+		Tags:        []string{TagDocumentation},
+		Config: []ConfigOption{
+			{Name: "title", Description: "Expected title, {dir} is replaced with the module directory name", Default: readmeTitleDirPlaceholder},
+		},
+		Example: `
+# README.md of modules/network
+# VPC module
+`,
+	}
+}
+
+// Check emits an issue when the first level 1 heading of README.md differs from the expected title.
+// A missing README is left to standard_module_structure.
+func (r *ModuleReadmeTitleRule) Check(runner tflint.Runner) error {
+	config := &moduleReadmeTitleRuleConfig{Title: readmeTitleDirPlaceholder}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	// README.md is not served by the runner, so it is read from the filesystem
+	filename := filepath.Join(dir, filenameReadme)
+	src, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	expected := strings.ReplaceAll(config.Title, readmeTitleDirPlaceholder, filepath.Base(abs))
+
+	for _, heading := range markdownHeadings(string(src)) {
+		if heading.Level != 1 {
+			continue
+		}
+		if heading.Text == expected {
+			return nil
+		}
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s title %q should be %q to match the module directory", filenameReadme, heading.Text, expected),
+			hcl.Range{
+				Filename: filename,
+				Start:    hcl.Pos{Line: heading.Line, Column: 1},
+			},
+		)
+	}
+
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf("%s should start with a %q title", filenameReadme, expected),
+		hcl.Range{
+			Filename: filename,
+			Start:    hcl.InitialPos,
+		},
+	)
+}
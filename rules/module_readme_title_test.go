@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleReadmeTitleRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   string
+		Files    func(dir string) map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name:     "missing README",
+			Files:    func(dir string) map[string]string { return map[string]string{} },
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "matching title",
+			Files: func(dir string) map[string]string {
+				return map[string]string{"README.md": fmt.Sprintf("%s\n===\n\n## Usage\n", filepath.Base(dir))}
+			},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "different title",
+			Files: func(dir string) map[string]string {
+				return map[string]string{"README.md": "<!-- BEGIN_TF_DOCS -->\n\n```markdown\n# Example\n```\n\n# VPC module\n\n# Usage\n"}
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleReadmeTitleRule(),
+						Message: fmt.Sprintf(`README.md title "VPC module" should be %q to match the module directory`, filepath.Base(dir)),
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "README.md"),
+							Start:    hcl.Pos{Line: 7, Column: 1},
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "missing title",
+			Files: func(dir string) map[string]string {
+				return map[string]string{"README.md": "## Usage\n"}
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleReadmeTitleRule(),
+						Message: fmt.Sprintf(`README.md should start with a %q title`, filepath.Base(dir)),
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "README.md"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "custom title",
+			Config: `
+rule "module_readme_title" {
+  enabled = true
+  title   = "terraform-aws-{dir}"
+}
+`,
+			Files: func(dir string) map[string]string {
+				return map[string]string{"README.md": fmt.Sprintf("# terraform-aws-%s\n", filepath.Base(dir))}
+			},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+	}
+
+	rule := NewModuleReadmeTitleRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.Files(dir))
+
+			content := map[string]string{filepath.Join(dir, "main.tf"): ""}
+			if tc.Config != "" {
+				content[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
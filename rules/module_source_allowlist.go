@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// moduleSourceAllowlistRuleConfig is the config structure for the module_source_allowlist rule
+type moduleSourceAllowlistRuleConfig struct {
+	AllowedPrefixes []string `hclext:"allowed_prefixes,optional"`
+	AllowLocal      *bool    `hclext:"allow_local,optional"`
+}
+
+// ModuleSourceAllowlistRule checks whether module sources come from allowed locations
+type ModuleSourceAllowlistRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleSourceAllowlistRule returns a new rule
+func NewModuleSourceAllowlistRule() *ModuleSourceAllowlistRule {
+	return &ModuleSourceAllowlistRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleSourceAllowlistRule) Name() string {
+	return "module_source_allowlist"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleSourceAllowlistRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleSourceAllowlistRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *ModuleSourceAllowlistRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleSourceAllowlistRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Restrict module sources to allowed prefixes",
+		Tags:        []string{TagSecurity},
+		Config: []ConfigOption{
+			{Name: "allowed_prefixes", Description: "Prefixes module sources must start with, e.g. app.terraform.io/acme/. Empty disables the check", Default: "[]"},
+			{Name: "allow_local", Description: "Accept local paths starting with ./ or ../", Default: "true"},
+		},
+		Example: `
+module "consul" {
+  source  = "hashicorp/consul/aws"
+  version = "0.11.0"
+}
+`,
+	}
+}
+
+// Check emits issues for module sources that do not start with any of the allowed prefixes
+func (r *ModuleSourceAllowlistRule) Check(runner tflint.Runner) error {
+	config := &moduleSourceAllowlistRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if len(config.AllowedPrefixes) == 0 {
+		return nil
+	}
+	allowLocal := config.AllowLocal == nil || *config.AllowLocal
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "module",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "source"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, module := range body.Blocks {
+		attr, exists := module.Body.Attributes["source"]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(source string) error {
+			if allowLocal && isLocalSource(source) {
+				return nil
+			}
+			for _, prefix := range config.AllowedPrefixes {
+				if strings.HasPrefix(source, prefix) {
+					return nil
+				}
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("module %q source %q is not allowed, use a source starting with one of: %s", module.Labels[0], source, strings.Join(config.AllowedPrefixes, ", ")),
+				attr.Range,
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleSourceAllowlistRule(t *testing.T) {
+	config := `
+rule "module_source_allowlist" {
+  enabled          = true
+  allowed_prefixes = ["app.terraform.io/acme/", "git::ssh://git@github.com/acme/"]
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no config",
+			Content: map[string]string{
+				"main.tf": `
+module "consul" {
+  source = "hashicorp/consul/aws"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "allowed sources",
+			Content: map[string]string{
+				"main.tf": `
+module "network" {
+  source  = "app.terraform.io/acme/network/aws"
+  version = "1.0.0"
+}
+module "dns" {
+  source = "git::ssh://git@github.com/acme/dns.git?ref=v1.0.0"
+}
+module "local" {
+  source = "./modules/local"
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "public registry source",
+			Content: map[string]string{
+				"main.tf": `
+module "consul" {
+  source = "hashicorp/consul/aws"
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModuleSourceAllowlistRule(),
+					Message: `module "consul" source "hashicorp/consul/aws" is not allowed, use a source starting with one of: app.terraform.io/acme/, git::ssh://git@github.com/acme/`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "local sources disallowed",
+			Content: map[string]string{
+				"main.tf": `
+module "local" {
+  source = "./modules/local"
+}
+`,
+				".tflint.hcl": `
+rule "module_source_allowlist" {
+  enabled          = true
+  allowed_prefixes = ["app.terraform.io/acme/"]
+  allow_local      = false
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModuleSourceAllowlistRule(),
+					Message: `module "local" source "./modules/local" is not allowed, use a source starting with one of: app.terraform.io/acme/`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleSourceAllowlistRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
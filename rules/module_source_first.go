@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleSourceFirstRule checks whether source and version are the first arguments in module blocks
+type ModuleSourceFirstRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleSourceFirstRule returns a new rule
+func NewModuleSourceFirstRule() *ModuleSourceFirstRule {
+	return &ModuleSourceFirstRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleSourceFirstRule) Name() string {
+	return "module_source_first"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleSourceFirstRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *ModuleSourceFirstRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *ModuleSourceFirstRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleSourceFirstRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require source and version to be the first arguments in module blocks",
+		Tags:        []string{TagStyle},
+		Example: `
+module "network" {
+  name   = "main"
+  source = "./modules/network"
+}
+`,
+	}
+}
+
+// Check emits issues for module blocks where source is not the first argument,
+// or version does not directly follow it
+func (r *ModuleSourceFirstRule) Check(runner tflint.Runner) error {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			// JSON files are likely generated
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+			if err := r.checkModule(runner, block); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *ModuleSourceFirstRule) checkModule(runner tflint.Runner, block *hclsyntax.Block) error {
+	items := bodyItems(block.Body)
+	for i, item := range items {
+		switch {
+		case item.name == "source" && i != 0:
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("source should be the first argument in module %q", block.Labels[0]),
+				item.rng,
+			)
+		case item.name == "version" && (i == 0 || items[i-1].name != "source"):
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("version should directly follow source in module %q", block.Labels[0]),
+				item.rng,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleSourceFirstRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "source and version first",
+			Content: map[string]string{
+				"main.tf": `
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.0.0"
+
+  name = "main"
+}
+module "local" {
+  source = "./modules/local"
+  count  = 2
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "source not first",
+			Content: map[string]string{
+				"main.tf": `
+module "vpc" {
+  name   = "main"
+  source = "./modules/vpc"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModuleSourceFirstRule(),
+					Message: `source should be the first argument in module "vpc"`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "version apart from source",
+			Content: map[string]string{
+				"main.tf": `
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  name    = "main"
+  version = "5.0.0"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewModuleSourceFirstRule(),
+					Message: `version should directly follow source in module "vpc"`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 20},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleSourceFirstRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
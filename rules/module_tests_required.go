@@ -0,0 +1,125 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const dirnameTests = "tests"
+
+// moduleTestsRequiredRuleConfig is the config structure for the module_tests_required rule
+type moduleTestsRequiredRuleConfig struct {
+	Directory string `hclext:"directory,optional"`
+}
+
+// ModuleTestsRequiredRule checks whether a module includes tests for the terraform test command
+type ModuleTestsRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewModuleTestsRequiredRule returns a new rule
+func NewModuleTestsRequiredRule() *ModuleTestsRequiredRule {
+	return &ModuleTestsRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleTestsRequiredRule) Name() string {
+	return "module_tests_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ModuleTestsRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleTestsRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ModuleTestsRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ModuleTestsRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require a tests directory with at least one .tftest.hcl file in the module root",
+		Tags:        []string{TagStructure},
+		Config: []ConfigOption{
+			{Name: "directory", Description: "Directory, relative to the module root, that holds the test files", Default: "tests"},
+		},
+		Example: `
+# A module without a tests/<name>.tftest.hcl file
+`,
+	}
+}
+
+// Check emits an issue when the tests directory is missing or has no .tftest.hcl or .tftest.json files
+func (r *ModuleTestsRequiredRule) Check(runner tflint.Runner) error {
+	config := &moduleTestsRequiredRuleConfig{Directory: dirnameTests}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	testsDir := filepath.Join(dir, config.Directory)
+	entries, err := os.ReadDir(testsDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("Module should include a %s directory with at least one .tftest.hcl file", config.Directory),
+			hcl.Range{
+				Filename: testsDir,
+				Start:    hcl.InitialPos,
+			},
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(entry.Name(), ".tftest.hcl") || strings.HasSuffix(entry.Name(), ".tftest.json") {
+			return nil
+		}
+	}
+
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf("%s directory should contain at least one .tftest.hcl file", config.Directory),
+		hcl.Range{
+			Filename: testsDir,
+			Start:    hcl.InitialPos,
+		},
+	)
+}
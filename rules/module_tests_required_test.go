@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ModuleTestsRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   string
+		Files    map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name:     "test file present",
+			Files:    map[string]string{"tests/defaults.tftest.hcl": "run \"defaults\" {}"},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name:  "missing tests directory",
+			Files: map[string]string{},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleTestsRequiredRule(),
+						Message: "Module should include a tests directory with at least one .tftest.hcl file",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "tests"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "tests directory without test files",
+			Files: map[string]string{
+				"tests/README.md":             "# Tests",
+				"tests/setup/main.tf":         "",
+				"tests/fixtures/a.tftest.hcl": "run \"nested\" {}",
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewModuleTestsRequiredRule(),
+						Message: "tests directory should contain at least one .tftest.hcl file",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "tests"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "custom directory",
+			Config: `
+rule "module_tests_required" {
+  enabled   = true
+  directory = "test"
+}
+`,
+			Files:    map[string]string{"test/main.tftest.json": "{}"},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+	}
+
+	rule := NewModuleTestsRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.Files)
+
+			content := map[string]string{filepath.Join(dir, "main.tf"): ""}
+			if tc.Config != "" {
+				content[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// MovedBlockChainRule checks whether moved blocks form chains or cycles
+type MovedBlockChainRule struct {
+	tflint.DefaultRule
+}
+
+// NewMovedBlockChainRule returns a new rule
+func NewMovedBlockChainRule() *MovedBlockChainRule {
+	return &MovedBlockChainRule{}
+}
+
+// Name returns the rule name
+func (r *MovedBlockChainRule) Name() string {
+	return "moved_block_chain"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *MovedBlockChainRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *MovedBlockChainRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *MovedBlockChainRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *MovedBlockChainRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow moved blocks that form chains or cycles",
+		Tags:        []string{TagCorrectness},
+		Example: `
+moved {
+  from = aws_instance.a
+  to   = aws_instance.b
+}
+
+moved {
+  from = aws_instance.b
+  to   = aws_instance.c
+}
+`,
+	}
+}
+
+// move is a moved block with its addresses
+type move struct {
+	block    *hclext.Block
+	from, to string
+}
+
+// Check emits an issue on every moved block taking part in a chain, where one block moves an object to the
+// address another block moves it from, or in a cycle, where the moves lead back to the first address.
+// Addresses are compared as written, including instance keys.
+func (r *MovedBlockChainRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "moved",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "from"}, {Name: "to"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	moves := []*move{}
+	byFrom := map[string]*move{}
+	targets := map[string]bool{}
+	for _, block := range body.Blocks {
+		from, fromOK := movedAddress(block.Body.Attributes["from"])
+		to, toOK := movedAddress(block.Body.Attributes["to"])
+		if !fromOK || !toOK {
+			continue
+		}
+		m := &move{block: block, from: from, to: to}
+		moves = append(moves, m)
+		if _, exists := byFrom[from]; !exists {
+			byFrom[from] = m
+		}
+		targets[to] = true
+	}
+
+	reported := map[*move]bool{}
+	emit := func(path []*move, message string) error {
+		for _, m := range path {
+			reported[m] = true
+		}
+		for _, m := range path {
+			if err := runner.EmitIssue(r, message, m.block.DefRange); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, start := range moves {
+		if reported[start] {
+			continue
+		}
+		path := []*move{start}
+		for next := byFrom[start.to]; next != nil && next != start && len(path) <= len(moves); next = byFrom[next.to] {
+			path = append(path, next)
+		}
+		if byFrom[path[len(path)-1].to] != start {
+			continue
+		}
+
+		addrs := []string{start.from}
+		for _, m := range path {
+			addrs = append(addrs, m.to)
+		}
+		if err := emit(path, fmt.Sprintf("moved blocks form a cycle %s, remove them", strings.Join(addrs, " -> "))); err != nil {
+			return err
+		}
+	}
+
+	for _, head := range moves {
+		if reported[head] || targets[head.from] {
+			continue
+		}
+		path := []*move{head}
+		for next := byFrom[head.to]; next != nil && !reported[next]; next = byFrom[next.to] {
+			path = append(path, next)
+		}
+		if len(path) < 2 {
+			continue
+		}
+
+		addrs := []string{head.from}
+		for _, m := range path {
+			addrs = append(addrs, m.to)
+		}
+		message := fmt.Sprintf(
+			"moved blocks form a chain %s, replace them with a single moved block from %s to %s",
+			strings.Join(addrs, " -> "),
+			head.from,
+			addrs[len(addrs)-1],
+		)
+		if err := emit(path, message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// movedAddress returns the address in a from or to attribute as written, e.g. aws_instance.web["a"]
+func movedAddress(attr *hclext.Attribute) (string, bool) {
+	if attr == nil {
+		return "", false
+	}
+	traversal, diags := hcl.AbsTraversalForExpr(attr.Expr)
+	if diags.HasErrors() {
+		return "", false
+	}
+
+	var addr strings.Builder
+	addr.WriteString(traversal.RootName())
+	for _, step := range traversal[1:] {
+		switch step := step.(type) {
+		case hcl.TraverseAttr:
+			addr.WriteString("." + step.Name)
+		case hcl.TraverseIndex:
+			switch {
+			case step.Key.Type() == cty.String:
+				fmt.Fprintf(&addr, "[%q]", step.Key.AsString())
+			case step.Key.Type() == cty.Number:
+				fmt.Fprintf(&addr, "[%s]", step.Key.AsBigFloat().Text('f', -1))
+			default:
+				return "", false
+			}
+		default:
+			return "", false
+		}
+	}
+	return addr.String(), true
+}
@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_MovedBlockChainRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "independent moves",
+			Content: `
+moved {
+  from = aws_instance.a
+  to   = aws_instance.b
+}
+
+moved {
+  from = aws_instance.b[0]
+  to   = aws_instance.c
+}
+
+moved {
+  from = module.old
+  to   = module.new
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "chain",
+			Content: `
+moved {
+  from = aws_instance.b["x"]
+  to   = aws_instance.c
+}
+
+moved {
+  from = aws_instance.a
+  to   = aws_instance.b["x"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewMovedBlockChainRule(),
+					Message: `moved blocks form a chain aws_instance.a -> aws_instance.b["x"] -> aws_instance.c, replace them with a single moved block from aws_instance.a to aws_instance.c`,
+					Range: hcl.Range{
+						Filename: "moved.tf",
+						Start:    hcl.Pos{Line: 7, Column: 1},
+						End:      hcl.Pos{Line: 7, Column: 6},
+					},
+				},
+				{
+					Rule:    NewMovedBlockChainRule(),
+					Message: `moved blocks form a chain aws_instance.a -> aws_instance.b["x"] -> aws_instance.c, replace them with a single moved block from aws_instance.a to aws_instance.c`,
+					Range: hcl.Range{
+						Filename: "moved.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "cycle",
+			Content: `
+moved {
+  from = module.a
+  to   = module.b
+}
+
+moved {
+  from = module.b
+  to   = module.a
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewMovedBlockChainRule(),
+					Message: "moved blocks form a cycle module.a -> module.b -> module.a, remove them",
+					Range: hcl.Range{
+						Filename: "moved.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 6},
+					},
+				},
+				{
+					Rule:    NewMovedBlockChainRule(),
+					Message: "moved blocks form a cycle module.a -> module.b -> module.a, remove them",
+					Range: hcl.Range{
+						Filename: "moved.tf",
+						Start:    hcl.Pos{Line: 7, Column: 1},
+						End:      hcl.Pos{Line: 7, Column: 6},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewMovedBlockChainRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"moved.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// nestedConditionalRuleConfig is the config structure for the nested_conditional rule
+type nestedConditionalRuleConfig struct {
+	MaxDepth int `hclext:"max_depth,optional"`
+}
+
+// NestedConditionalRule checks whether conditional expressions are nested too deeply
+type NestedConditionalRule struct {
+	tflint.DefaultRule
+}
+
+// NewNestedConditionalRule returns a new rule
+func NewNestedConditionalRule() *NestedConditionalRule {
+	return &NestedConditionalRule{}
+}
+
+// Name returns the rule name
+func (r *NestedConditionalRule) Name() string {
+	return "nested_conditional"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NestedConditionalRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *NestedConditionalRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *NestedConditionalRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NestedConditionalRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Limit how deeply conditional expressions are nested",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "max_depth", Description: "Maximum number of nested conditional expressions", Default: "1"},
+		},
+		Example: `
+locals {
+  size = var.env == "prd" ? "large" : var.env == "stg" ? "medium" : "small"
+}
+`,
+	}
+}
+
+// Check emits an issue for the outermost conditional expression of each nest deeper than max_depth
+func (r *NestedConditionalRule) Check(runner tflint.Runner) error {
+	config := &nestedConditionalRuleConfig{MaxDepth: 1}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	isConditional := func(node hclsyntax.Node) bool {
+		_, ok := node.(*hclsyntax.ConditionalExpr)
+		return ok
+	}
+
+	// Expressions are walked outside in, so conditionals within a reported one are skipped
+	reported := []hcl.Range{}
+	diags := runner.WalkExpressions(tflint.ExprWalkFunc(func(expr hcl.Expression) hcl.Diagnostics {
+		conditional, ok := expr.(*hclsyntax.ConditionalExpr)
+		if !ok {
+			return nil
+		}
+		rng := conditional.Range()
+		for _, outer := range reported {
+			if outer.Filename == rng.Filename && outer.ContainsOffset(rng.Start.Byte) {
+				return nil
+			}
+		}
+
+		depth := nestingDepth(conditional, isConditional)
+		if depth <= config.MaxDepth {
+			return nil
+		}
+		reported = append(reported, rng)
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("conditional expression is nested %d levels deep (max %d), use a lookup map in locals instead", depth, config.MaxDepth),
+			rng,
+		); err != nil {
+			return hcl.Diagnostics{
+				{
+					Severity: hcl.DiagError,
+					Summary:  "failed to call EmitIssue()",
+					Detail:   err.Error(),
+				},
+			}
+		}
+		return nil
+	}))
+	if diags.HasErrors() {
+		return diags
+	}
+
+	return nil
+}
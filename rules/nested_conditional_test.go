@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NestedConditionalRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "single conditional",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  size = var.env == "prd" ? "large" : "small"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "nested conditional",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  size = var.env == "prd" ? "large" : var.env == "stg" ? "medium" : "small"
+}
+locals {
+  name = var.short ? "a" : (var.long ? "b" : (var.extra ? "c" : "d"))
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNestedConditionalRule(),
+					Message: "conditional expression is nested 2 levels deep (max 1), use a lookup map in locals instead",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 3, Column: 76},
+					},
+				},
+				{
+					Rule:    NewNestedConditionalRule(),
+					Message: "conditional expression is nested 3 levels deep (max 1), use a lookup map in locals instead",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 10},
+						End:      hcl.Pos{Line: 6, Column: 70},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom depth",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  size = var.env == "prd" ? "large" : var.env == "stg" ? "medium" : "small"
+}
+`,
+				".tflint.hcl": `
+rule "nested_conditional" {
+  enabled   = true
+  max_depth = 2
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewNestedConditionalRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package rules
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const dirnameModules = "modules"
+
+// NestedModuleStructureRule checks whether nested modules live under modules/ and follow the standard structure
+type NestedModuleStructureRule struct {
+	tflint.DefaultRule
+}
+
+// NewNestedModuleStructureRule returns a new rule
+func NewNestedModuleStructureRule() *NestedModuleStructureRule {
+	return &NestedModuleStructureRule{}
+}
+
+// Name returns the rule name
+func (r *NestedModuleStructureRule) Name() string {
+	return "nested_module_structure"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NestedModuleStructureRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *NestedModuleStructureRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *NestedModuleStructureRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NestedModuleStructureRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Ensure that nested modules live under modules/ and follow the standard structure",
+		Tags:        []string{TagStructure},
+		Example: `
+module "network" {
+  source = "./network"
+}
+`,
+	}
+}
+
+// Check emits issues for local module calls outside modules/ and nested modules missing standard files
+func (r *NestedModuleStructureRule) Check(runner tflint.Runner) error {
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "module",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "source"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, module := range body.Blocks {
+		attr, exists := module.Body.Attributes["source"]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(source string) error {
+			if !strings.HasPrefix(source, "./") {
+				return nil
+			}
+			if strings.HasPrefix(filepath.ToSlash(filepath.Clean(source)), dirnameModules+"/") {
+				return nil
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("module %q source %q should point to a nested module under ./%s/", module.Labels[0], source, dirnameModules),
+				attr.Range,
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.checkNestedModules(runner, filepath.Join(dir, dirnameModules))
+}
+
+func (r *NestedModuleStructureRule) checkNestedModules(runner tflint.Runner, modulesDir string) error {
+	entries, err := os.ReadDir(modulesDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, filename := range []string{filenameMain, filenameVariables, filenameOutputs} {
+			path := filepath.Join(modulesDir, name, filename)
+			if _, err := os.Stat(path); err == nil {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Nested module %s is missing %s", filepath.Join(dirnameModules, name), filename),
+				hcl.Range{
+					Filename: path,
+					Start:    hcl.InitialPos,
+				},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
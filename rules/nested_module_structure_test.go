@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NestedModuleStructureRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Dirs     map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name: "nested module under modules",
+			Content: `
+module "a" { source = "./modules/a" }
+module "b" { source = "terraform-aws-modules/vpc/aws" }
+`,
+			Dirs: map[string]string{
+				"modules/a/main.tf":      "",
+				"modules/a/variables.tf": "",
+				"modules/a/outputs.tf":   "",
+			},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "local source outside modules",
+			Content: `
+module "a" { source = "./lib/a" }
+`,
+			Dirs: map[string]string{},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewNestedModuleStructureRule(),
+						Message: `module "a" source "./lib/a" should point to a nested module under ./modules/`,
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "main.tf"),
+							Start:    hcl.Pos{Line: 2, Column: 14},
+							End:      hcl.Pos{Line: 2, Column: 32},
+						},
+					},
+				}
+			},
+		},
+		{
+			Name:    "incomplete nested module",
+			Content: "",
+			Dirs: map[string]string{
+				"modules/a/main.tf": "",
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewNestedModuleStructureRule(),
+						Message: "Nested module modules/a is missing variables.tf",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "modules", "a", "variables.tf"),
+							Start:    hcl.InitialPos,
+						},
+					},
+					{
+						Rule:    NewNestedModuleStructureRule(),
+						Message: "Nested module modules/a is missing outputs.tf",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "modules", "a", "outputs.tf"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+	}
+
+	rule := NewNestedModuleStructureRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.Dirs)
+
+			runner := helper.TestRunner(t, map[string]string{filepath.Join(dir, "main.tf"): tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// noEnvironmentInNamesRuleConfig is the config structure for the no_environment_in_names rule
+type noEnvironmentInNamesRuleConfig struct {
+	Environments  []string `hclext:"environments,optional"`
+	ExemptModules []string `hclext:"exempt_modules,optional"`
+}
+
+// NoEnvironmentInNamesRule checks whether resource and module call names contain environment identifiers
+type NoEnvironmentInNamesRule struct {
+	tflint.DefaultRule
+}
+
+// NewNoEnvironmentInNamesRule returns a new rule
+func NewNoEnvironmentInNamesRule() *NoEnvironmentInNamesRule {
+	return &NoEnvironmentInNamesRule{}
+}
+
+// Name returns the rule name
+func (r *NoEnvironmentInNamesRule) Name() string {
+	return "no_environment_in_names"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NoEnvironmentInNamesRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *NoEnvironmentInNamesRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *NoEnvironmentInNamesRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NoEnvironmentInNamesRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow environment identifiers in resource and module call names",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "environments", Description: "Environment identifiers, matched case-insensitively against the words of names", Default: `["dev", "staging", "prod"]`},
+			{Name: "exempt_modules", Description: "Path globs of module directories that compose environments, such as live roots", Default: "[]"},
+		},
+		Example: `
+resource "aws_s3_bucket" "prod_logs" {
+  bucket = "${var.name}-logs"
+}
+`,
+	}
+}
+
+// Check emits issues for resources and module calls whose name has an environment identifier as one of the
+// words separated by underscores or dashes
+func (r *NoEnvironmentInNamesRule) Check(runner tflint.Runner) error {
+	config := &noEnvironmentInNamesRuleConfig{Environments: []string{"dev", "staging", "prod"}}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	environments := make(map[string]bool, len(config.Environments))
+	for _, env := range config.Environments {
+		environments[strings.ToLower(env)] = true
+	}
+	exemptModules := make([]*regexp.Regexp, 0, len(config.ExemptModules))
+	for _, glob := range config.ExemptModules {
+		pattern, err := compileGlob(glob)
+		if err != nil {
+			return fmt.Errorf("invalid exempt_modules glob %q: %w", glob, err)
+		}
+		exemptModules = append(exemptModules, pattern)
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: &hclext.BodySchema{}},
+			{Type: "module", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, block := range body.Blocks {
+		dir := filepath.ToSlash(filepath.Dir(block.DefRange.Filename))
+		if matchesAny(exemptModules, dir) {
+			continue
+		}
+
+		name := block.Labels[len(block.Labels)-1]
+		words := strings.FieldsFunc(strings.ToLower(name), func(c rune) bool { return c == '_' || c == '-' })
+		for _, word := range words {
+			if !environments[word] {
+				continue
+			}
+
+			what := fmt.Sprintf("module %q", name)
+			if block.Type == "resource" {
+				what = fmt.Sprintf(`resource "%s" "%s"`, block.Labels[0], name)
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s is named after the %q environment, name it after its role so the module can be reused across environments", what, word),
+				block.DefRange,
+			); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	return nil
+}
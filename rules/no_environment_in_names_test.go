@@ -0,0 +1,130 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NoEnvironmentInNamesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Filename string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name:     "role names",
+			Filename: "main.tf",
+			Content: `
+resource "aws_s3_bucket" "product_images" {
+  bucket = "${var.name}-images"
+}
+
+module "devices" {
+  source = "./devices"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name:     "environment names",
+			Filename: "main.tf",
+			Content: `
+resource "aws_s3_bucket" "Prod_logs" {
+  bucket = "${var.name}-logs"
+}
+
+module "network-staging" {
+  source = "./network"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoEnvironmentInNamesRule(),
+					Message: `resource "aws_s3_bucket" "Prod_logs" is named after the "prod" environment, name it after its role so the module can be reused across environments`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 37},
+					},
+				},
+				{
+					Rule:    NewNoEnvironmentInNamesRule(),
+					Message: `module "network-staging" is named after the "staging" environment, name it after its role so the module can be reused across environments`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name:     "exempt modules",
+			Filename: "live/prod/main.tf",
+			Content: `
+module "network_prod" {
+  source = "../../modules/network"
+}
+`,
+			Config: `
+rule "no_environment_in_names" {
+  enabled        = true
+  exempt_modules = ["live/**"]
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name:     "custom environments",
+			Filename: "main.tf",
+			Content: `
+resource "aws_s3_bucket" "prod_logs" {
+  bucket = "${var.name}-logs"
+}
+
+resource "aws_s3_bucket" "qa_logs" {
+  bucket = "${var.name}-qa-logs"
+}
+`,
+			Config: `
+rule "no_environment_in_names" {
+  enabled      = true
+  environments = ["QA"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoEnvironmentInNamesRule(),
+					Message: `resource "aws_s3_bucket" "qa_logs" is named after the "qa" environment, name it after its role so the module can be reused across environments`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 35},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewNoEnvironmentInNamesRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{tc.Filename: tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
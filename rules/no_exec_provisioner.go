@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// execProvisioners are the provisioner types that run arbitrary commands
+var execProvisioners = []string{"local-exec", "remote-exec"}
+
+// noExecProvisionerRuleConfig is the config structure for the no_exec_provisioner rule
+type noExecProvisionerRuleConfig struct {
+	AllowedTypes     []string `hclext:"allowed_types,optional"`
+	AllowedResources []string `hclext:"allowed_resources,optional"`
+}
+
+// NoExecProvisionerRule checks whether resources use local-exec or remote-exec provisioners
+type NoExecProvisionerRule struct {
+	tflint.DefaultRule
+}
+
+// NewNoExecProvisionerRule returns a new rule
+func NewNoExecProvisionerRule() *NoExecProvisionerRule {
+	return &NoExecProvisionerRule{}
+}
+
+// Name returns the rule name
+func (r *NoExecProvisionerRule) Name() string {
+	return "no_exec_provisioner"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NoExecProvisionerRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *NoExecProvisionerRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *NoExecProvisionerRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NoExecProvisionerRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow local-exec and remote-exec provisioners",
+		Tags:        []string{TagSecurity},
+		Config: []ConfigOption{
+			{Name: "allowed_types", Description: "Provisioner types that are allowed", Default: "[]"},
+			{Name: "allowed_resources", Description: "Resource addresses that may use any provisioner", Default: "[]"},
+		},
+		Example: `
+resource "null_resource" "setup" {
+  provisioner "local-exec" {
+    command = "./setup.sh"
+  }
+}
+`,
+	}
+}
+
+// Check emits issues for local-exec and remote-exec provisioners, except for types in allowed_types
+// and resource addresses (e.g. null_resource.bootstrap) in allowed_resources
+func (r *NoExecProvisionerRule) Check(runner tflint.Runner) error {
+	config := &noExecProvisionerRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	forbidden := map[string]bool{}
+	for _, t := range execProvisioners {
+		forbidden[t] = true
+	}
+	for _, t := range config.AllowedTypes {
+		delete(forbidden, t)
+	}
+	allowedResources := map[string]bool{}
+	for _, addr := range config.AllowedResources {
+		allowedResources[addr] = true
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type:       "provisioner",
+							LabelNames: []string{"type"},
+							Body:       &hclext.BodySchema{},
+						},
+					},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range body.Blocks {
+		addr := fmt.Sprintf("%s.%s", resource.Labels[0], resource.Labels[1])
+		if allowedResources[addr] {
+			continue
+		}
+
+		for _, provisioner := range resource.Body.Blocks {
+			if !forbidden[provisioner.Labels[0]] {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%q provisioner in %s is not allowed, use native resources or instance user data instead", provisioner.Labels[0], addr),
+				provisioner.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
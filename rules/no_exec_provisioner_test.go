@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NoExecProvisionerRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "file provisioner",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  provisioner "file" {}
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "exec provisioners",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  provisioner "local-exec" {
+    command = "echo hello"
+  }
+  provisioner "remote-exec" {
+    inline = ["echo hello"]
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoExecProvisionerRule(),
+					Message: `"local-exec" provisioner in aws_instance.web is not allowed, use native resources or instance user data instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 27},
+					},
+				},
+				{
+					Rule:    NewNoExecProvisionerRule(),
+					Message: `"remote-exec" provisioner in aws_instance.web is not allowed, use native resources or instance user data instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 3},
+						End:      hcl.Pos{Line: 6, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "allowed types and resources",
+			Content: map[string]string{
+				"main.tf": `
+resource "null_resource" "bootstrap" {
+  provisioner "remote-exec" {}
+}
+resource "aws_instance" "web" {
+  provisioner "local-exec" {}
+  provisioner "remote-exec" {}
+}
+`,
+				".tflint.hcl": `
+rule "no_exec_provisioner" {
+  enabled           = true
+  allowed_types     = ["local-exec"]
+  allowed_resources = ["null_resource.bootstrap"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoExecProvisionerRule(),
+					Message: `"remote-exec" provisioner in aws_instance.web is not allowed, use native resources or instance user data instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 28},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewNoExecProvisionerRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
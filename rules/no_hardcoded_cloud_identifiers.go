@@ -0,0 +1,171 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// defaultCloudIdentifierPatterns are the kinds of cloud identifiers reported by default and their patterns
+var defaultCloudIdentifierPatterns = map[string]string{
+	"AWS account ID": `(^|[^0-9])[0-9]{12}([^0-9]|$)`,
+	"AWS ARN":        `arn:aws(-[a-z]+)*:`,
+	"region":         `(^|[^a-z])(us|eu|ap|sa|ca|me|af|il|mx)-(gov-)?(north|south|east|west|central|northeast|southeast|northwest|southwest)-[0-9]([^0-9]|$)`,
+}
+
+// noHardcodedCloudIdentifiersRuleConfig is the config structure for the no_hardcoded_cloud_identifiers rule
+type noHardcodedCloudIdentifiersRuleConfig struct {
+	Patterns map[string]string `hclext:"patterns,optional"`
+}
+
+// NoHardcodedCloudIdentifiersRule checks whether resource arguments hardcode account IDs, ARNs or regions
+type NoHardcodedCloudIdentifiersRule struct {
+	tflint.DefaultRule
+}
+
+// NewNoHardcodedCloudIdentifiersRule returns a new rule
+func NewNoHardcodedCloudIdentifiersRule() *NoHardcodedCloudIdentifiersRule {
+	return &NoHardcodedCloudIdentifiersRule{}
+}
+
+// Name returns the rule name
+func (r *NoHardcodedCloudIdentifiersRule) Name() string {
+	return "no_hardcoded_cloud_identifiers"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NoHardcodedCloudIdentifiersRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *NoHardcodedCloudIdentifiersRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *NoHardcodedCloudIdentifiersRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NoHardcodedCloudIdentifiersRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow hardcoded account IDs, ARNs and regions in resource and data source arguments",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "patterns", Description: "Map of identifier kinds to regular expressions matched against string literals. Setting it replaces the defaults, which match 12-digit AWS account IDs, AWS ARNs and AWS region names", Default: "{ \"AWS account ID\" = ..., \"AWS ARN\" = ..., region = ... }"},
+		},
+		Example: `
+resource "aws_iam_role_policy_attachment" "admin" {
+  role       = aws_iam_role.admin.name
+  policy_arn = "arn:aws:iam::123456789012:policy/admin"
+}
+`,
+	}
+}
+
+// Check emits an issue for every string literal in a resource or data source argument, including arguments
+// of nested blocks, that matches one of the patterns
+func (r *NoHardcodedCloudIdentifiersRule) Check(runner tflint.Runner) error {
+	config := &noHardcodedCloudIdentifiersRuleConfig{Patterns: defaultCloudIdentifierPatterns}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	kinds := make([]string, 0, len(config.Patterns))
+	patterns := make(map[string]*regexp.Regexp, len(config.Patterns))
+	for kind, p := range config.Patterns {
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q for %s: %w", p, kind, err)
+		}
+		kinds = append(kinds, kind)
+		patterns[kind] = pattern
+	}
+	sort.Strings(kinds)
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			for _, str := range jsonStrings(files[name].Body) {
+				if str.path[0] != "resource" && str.path[0] != "data" {
+					continue
+				}
+				if err := r.checkValue(runner, kinds, patterns, str.value, str.rng); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type != "resource" && block.Type != "data" {
+				continue
+			}
+			if err := r.checkBody(runner, kinds, patterns, block.Body); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *NoHardcodedCloudIdentifiersRule) checkBody(runner tflint.Runner, kinds []string, patterns map[string]*regexp.Regexp, body *hclsyntax.Body) error {
+	for _, attr := range sortedAttributes(body) {
+		literals := []*hclsyntax.LiteralValueExpr{}
+		hclsyntax.VisitAll(attr.Expr, func(node hclsyntax.Node) hcl.Diagnostics {
+			if lit, ok := node.(*hclsyntax.LiteralValueExpr); ok && lit.Val.Type() == cty.String && lit.Val.IsKnown() && !lit.Val.IsNull() {
+				literals = append(literals, lit)
+			}
+			return nil
+		})
+
+		for _, lit := range literals {
+			if err := r.checkValue(runner, kinds, patterns, lit.Val.AsString(), lit.Range()); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, block := range body.Blocks {
+		if err := r.checkBody(runner, kinds, patterns, block.Body); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *NoHardcodedCloudIdentifiersRule) checkValue(runner tflint.Runner, kinds []string, patterns map[string]*regexp.Regexp, value string, rng hcl.Range) error {
+	for _, kind := range kinds {
+		if !patterns[kind].MatchString(value) {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("%q contains a hardcoded %s, use a variable or a data source instead", value, kind),
+			rng,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NoHardcodedCloudIdentifiersRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no identifiers",
+			Content: map[string]string{
+				"main.tf": `
+provider "aws" {
+  region = "us-east-1"
+}
+resource "aws_iam_role_policy_attachment" "admin" {
+  role       = aws_iam_role.admin.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:policy/admin"
+  tags       = { Build = "2024010112345" }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "ARN with account ID",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_iam_role_policy_attachment" "admin" {
+  policy_arn = "arn:aws:iam::123456789012:policy/admin"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedCloudIdentifiersRule(),
+					Message: `"arn:aws:iam::123456789012:policy/admin" contains a hardcoded AWS ARN, use a variable or a data source instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 55},
+					},
+				},
+				{
+					Rule:    NewNoHardcodedCloudIdentifiersRule(),
+					Message: `"arn:aws:iam::123456789012:policy/admin" contains a hardcoded AWS account ID, use a variable or a data source instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 55},
+					},
+				},
+			},
+		},
+		{
+			Name: "JSON configuration",
+			Content: map[string]string{
+				"main.tf.json": `{
+  "provider": {"aws": {"region": "us-east-1"}},
+  "resource": {
+    "aws_iam_role_policy_attachment": {
+      "admin": {"policy_arn": "arn:aws:iam::123456789012:policy/admin"}
+    }
+  }
+}`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedCloudIdentifiersRule(),
+					Message: `"arn:aws:iam::123456789012:policy/admin" contains a hardcoded AWS ARN, use a variable or a data source instead`,
+					Range: hcl.Range{
+						Filename: "main.tf.json",
+						Start:    hcl.Pos{Line: 5, Column: 31},
+						End:      hcl.Pos{Line: 5, Column: 71},
+					},
+				},
+				{
+					Rule:    NewNoHardcodedCloudIdentifiersRule(),
+					Message: `"arn:aws:iam::123456789012:policy/admin" contains a hardcoded AWS account ID, use a variable or a data source instead`,
+					Range: hcl.Range{
+						Filename: "main.tf.json",
+						Start:    hcl.Pos{Line: 5, Column: 31},
+						End:      hcl.Pos{Line: 5, Column: 71},
+					},
+				},
+			},
+		},
+		{
+			Name: "region in nested block",
+			Content: map[string]string{
+				"main.tf": `
+data "aws_iam_policy_document" "this" {
+  statement {
+    resources = ["*"]
+    condition {
+      test     = "StringEquals"
+      variable = "aws:RequestedRegion"
+      values   = ["eu-west-1"]
+    }
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedCloudIdentifiersRule(),
+					Message: `"eu-west-1" contains a hardcoded region, use a variable or a data source instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 20},
+						End:      hcl.Pos{Line: 8, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom patterns",
+			Content: map[string]string{
+				"main.tf": `
+resource "google_project_iam_member" "admin" {
+  project = "acme-prod-123456"
+  member  = "serviceAccount:ci@acme-prod-123456.iam.gserviceaccount.com"
+}
+`,
+				".tflint.hcl": `
+rule "no_hardcoded_cloud_identifiers" {
+  enabled = true
+  patterns = {
+    "GCP project ID" = "^acme-[a-z]+-[0-9]+$"
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedCloudIdentifiersRule(),
+					Message: `"acme-prod-123456" contains a hardcoded GCP project ID, use a variable or a data source instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 14},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewNoHardcodedCloudIdentifiersRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package rules
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// noHardcodedNetworkAddressesRuleConfig is the config structure for the no_hardcoded_network_addresses rule
+type noHardcodedNetworkAddressesRuleConfig struct {
+	Allowed      []string `hclext:"allowed,optional"`
+	AllowPrivate bool     `hclext:"allow_private,optional"`
+}
+
+// NoHardcodedNetworkAddressesRule checks whether string literals contain IP addresses or CIDR blocks
+type NoHardcodedNetworkAddressesRule struct {
+	tflint.DefaultRule
+}
+
+// NewNoHardcodedNetworkAddressesRule returns a new rule
+func NewNoHardcodedNetworkAddressesRule() *NoHardcodedNetworkAddressesRule {
+	return &NoHardcodedNetworkAddressesRule{}
+}
+
+// Name returns the rule name
+func (r *NoHardcodedNetworkAddressesRule) Name() string {
+	return "no_hardcoded_network_addresses"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NoHardcodedNetworkAddressesRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *NoHardcodedNetworkAddressesRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *NoHardcodedNetworkAddressesRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NoHardcodedNetworkAddressesRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow hardcoded IP addresses and CIDR blocks outside variable defaults",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "allowed", Description: "Addresses and CIDR blocks that may be hardcoded", Default: `["0.0.0.0/0", "::/0"]`},
+			{Name: "allow_private", Description: "Allow private addresses and blocks, such as the RFC 1918 ranges", Default: "false"},
+		},
+		Example: `
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+`,
+	}
+}
+
+// Check emits an issue for every IPv4 or IPv6 address or CIDR block found in a string literal,
+// except in variable defaults, which are where such constants belong
+func (r *NoHardcodedNetworkAddressesRule) Check(runner tflint.Runner) error {
+	config := &noHardcodedNetworkAddressesRuleConfig{
+		Allowed: []string{"0.0.0.0/0", "::/0"},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	allowed := make(map[string]bool, len(config.Allowed))
+	for _, address := range config.Allowed {
+		allowed[address] = true
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			if err := r.checkJSONBody(runner, config, allowed, files[name].Body); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := r.checkBody(runner, config, allowed, body, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *NoHardcodedNetworkAddressesRule) checkBody(runner tflint.Runner, config *noHardcodedNetworkAddressesRuleConfig, allowed map[string]bool, body *hclsyntax.Body, parent *hclsyntax.Block) error {
+	for _, attr := range sortedAttributes(body) {
+		if parent != nil && parent.Type == "variable" && attr.Name == "default" {
+			continue
+		}
+
+		literals := []*hclsyntax.LiteralValueExpr{}
+		hclsyntax.VisitAll(attr.Expr, func(node hclsyntax.Node) hcl.Diagnostics {
+			if lit, ok := node.(*hclsyntax.LiteralValueExpr); ok && lit.Val.Type() == cty.String && lit.Val.IsKnown() && !lit.Val.IsNull() {
+				literals = append(literals, lit)
+			}
+			return nil
+		})
+
+		for _, lit := range literals {
+			for _, address := range networkAddresses(lit.Val.AsString()) {
+				if allowed[address] || (config.AllowPrivate && isPrivateAddress(address)) {
+					continue
+				}
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("%q is a hardcoded network address, move it into a variable", address),
+					lit.Range(),
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, block := range body.Blocks {
+		if err := r.checkBody(runner, config, allowed, block.Body, block); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkJSONBody checks the string values of a JSON file, skipping variable defaults as in native files
+func (r *NoHardcodedNetworkAddressesRule) checkJSONBody(runner tflint.Runner, config *noHardcodedNetworkAddressesRuleConfig, allowed map[string]bool, body hcl.Body) error {
+	for _, str := range jsonStrings(body) {
+		if len(str.path) >= 3 && str.path[0] == "variable" && str.path[2] == "default" {
+			continue
+		}
+		for _, address := range networkAddresses(str.value) {
+			if allowed[address] || (config.AllowPrivate && isPrivateAddress(address)) {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%q is a hardcoded network address, move it into a variable", address),
+				str.rng,
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// networkAddresses returns the IP addresses and CIDR blocks in the text. Candidates are runs of hex digits,
+// dots, colons and slashes; runs that are not valid addresses, such as version numbers, are ignored.
+func networkAddresses(text string) []string {
+	candidates := strings.FieldsFunc(text, func(r rune) bool {
+		return !strings.ContainsRune("0123456789abcdefABCDEF.:/", r)
+	})
+
+	addresses := []string{}
+	for _, candidate := range candidates {
+		// IPv6 addresses may start and end with "::", so only dots and slashes are trimmed from the start,
+		// and trailing punctuation is trimmed only when the candidate is not an address as is.
+		// A trailing colon is only trimmed after IPv4 addresses, so AWS::EC2:: does not become ::EC2.
+		candidate = strings.TrimLeft(candidate, "./")
+		if address, ok := networkAddress(candidate); ok {
+			addresses = append(addresses, address)
+			continue
+		}
+		trailing := "./"
+		if strings.Count(candidate, ":") == 1 {
+			trailing = ".:/"
+		}
+		if address, ok := networkAddress(strings.TrimRight(candidate, trailing)); ok {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// networkAddress returns the candidate if it is an IP address or a CIDR block
+func networkAddress(candidate string) (string, bool) {
+	if strings.Count(candidate, ".") != 3 && strings.Count(candidate, ":") < 2 {
+		return "", false
+	}
+	if !strings.ContainsAny(candidate, "0123456789abcdefABCDEF") {
+		// A bare "::" is more likely a separator, as in AWS::S3::Bucket, than the unspecified address
+		return "", false
+	}
+	if _, _, err := net.ParseCIDR(candidate); err == nil {
+		return candidate, true
+	}
+	if _, _, err := net.ParseCIDR(candidate); err == nil {
+		return candidate, true
+	}
+	return candidate, net.ParseIP(candidate) != nil
+}
+
+// isPrivateAddress returns whether the address or CIDR block is within the private ranges of RFC 1918 and RFC 4193
+func isPrivateAddress(address string) bool {
+	if ip, network, err := net.ParseCIDR(address); err == nil {
+		ones, _ := network.Mask.Size()
+		// The whole block must be private, not just its first address
+		return ip.IsPrivate() && ones >= privatePrefixLength(ip)
+	}
+	ip := net.ParseIP(address)
+	return ip != nil && ip.IsPrivate()
+}
+
+// privatePrefixLength returns the prefix length of the private range containing the address
+func privatePrefixLength(ip net.IP) int {
+	if ip4 := ip.To4(); ip4 != nil {
+		switch {
+		case ip4[0] == 10:
+			return 8
+		case ip4[0] == 172:
+			return 12
+		default:
+			return 16
+		}
+	}
+	return 7
+}
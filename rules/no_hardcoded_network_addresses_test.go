@@ -0,0 +1,214 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NoHardcodedNetworkAddressesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no addresses",
+			Content: map[string]string{
+				"main.tf": `
+variable "vpc_cidr" {
+  default = "10.0.0.0/16"
+}
+resource "aws_vpc" "main" {
+  cidr_block = var.vpc_cidr
+  tags       = { Version = "1.2.3" }
+}
+resource "aws_security_group_rule" "egress" {
+  cidr_blocks = ["0.0.0.0/0"]
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "CIDR block",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedNetworkAddressesRule(),
+					Message: `"10.0.0.0/16" is a hardcoded network address, move it into a variable`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "addresses in templates and nested blocks",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  user_data = "server=${var.host},dns=2001:db8::53"
+  ebs_block_device {
+    tags = { Endpoint = "203.0.113.10" }
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedNetworkAddressesRule(),
+					Message: `"2001:db8::53" is a hardcoded network address, move it into a variable`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 34},
+						End:      hcl.Pos{Line: 3, Column: 51},
+					},
+				},
+				{
+					Rule:    NewNoHardcodedNetworkAddressesRule(),
+					Message: `"203.0.113.10" is a hardcoded network address, move it into a variable`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 26},
+						End:      hcl.Pos{Line: 5, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "private addresses allowed",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+resource "aws_security_group_rule" "ingress" {
+  cidr_blocks = ["10.0.0.0/7", "192.168.1.10"]
+}
+`,
+				".tflint.hcl": `
+rule "no_hardcoded_network_addresses" {
+  enabled       = true
+  allow_private = true
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedNetworkAddressesRule(),
+					Message: `"10.0.0.0/7" is a hardcoded network address, move it into a variable`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 19},
+						End:      hcl.Pos{Line: 6, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "IPv6 addresses",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_security_group_rule" "ingress" {
+  ipv6_cidr_blocks = ["::/0", "2001:db8::/32"]
+  description      = "health checks from ::1 and ::ffff:10.0.0.1, see AWS::EC2::SecurityGroup"
+}
+`,
+				".tflint.hcl": `
+rule "no_hardcoded_network_addresses" {
+  enabled = true
+  allowed = ["0.0.0.0/0"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedNetworkAddressesRule(),
+					Message: `"::/0" is a hardcoded network address, move it into a variable`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 24},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+				{
+					Rule:    NewNoHardcodedNetworkAddressesRule(),
+					Message: `"2001:db8::/32" is a hardcoded network address, move it into a variable`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 32},
+						End:      hcl.Pos{Line: 3, Column: 45},
+					},
+				},
+				{
+					Rule:    NewNoHardcodedNetworkAddressesRule(),
+					Message: `"::1" is a hardcoded network address, move it into a variable`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 23},
+						End:      hcl.Pos{Line: 4, Column: 94},
+					},
+				},
+				{
+					Rule:    NewNoHardcodedNetworkAddressesRule(),
+					Message: `"::ffff:10.0.0.1" is a hardcoded network address, move it into a variable`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 23},
+						End:      hcl.Pos{Line: 4, Column: 94},
+					},
+				},
+			},
+		},
+		{
+			Name: "JSON configuration",
+			Content: map[string]string{
+				"main.tf.json": `{
+  "variable": {
+    "cidr": {"default": "10.0.0.0/16"}
+  },
+  "resource": {
+    "aws_vpc": {
+      "main": {"cidr_block": "10.1.0.0/16"}
+    }
+  }
+}`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedNetworkAddressesRule(),
+					Message: `"10.1.0.0/16" is a hardcoded network address, move it into a variable`,
+					Range: hcl.Range{
+						Filename: "main.tf.json",
+						Start:    hcl.Pos{Line: 7, Column: 30},
+						End:      hcl.Pos{Line: 7, Column: 43},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewNoHardcodedNetworkAddressesRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package rules
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// secretNamePattern matches attribute and variable names with a _-separated segment that usually names a secret
+var secretNamePattern = regexp.MustCompile(`(?i)(^|_)(password|passwd|secret|token|api_?key|private_key|access_key)(_|$)`)
+
+// secretSettingPattern matches names that describe a secret rather than hold it, such as api_key_source or secret_name
+var secretSettingPattern = regexp.MustCompile(`(?i)_(arn|id|name|source|type)$`)
+
+// secretSettingBlocks are blocks whose attributes are named after secrets but hold settings,
+// such as the units of token lifetimes of aws_cognito_user_pool_client
+var secretSettingBlocks = map[string]bool{
+	"token_validity_units": true,
+}
+
+// secretValuePatterns are well-known credential formats
+var secretValuePatterns = []struct {
+	description string
+	pattern     *regexp.Regexp
+}{
+	{"an AWS access key ID", regexp.MustCompile(`\b(A3T[A-Z0-9]|AKIA|ASIA)[A-Z0-9]{16}\b`)},
+	{"a private key", regexp.MustCompile(`-----BEGIN ([A-Z]+ )?PRIVATE KEY-----`)},
+	{"a GitHub token", regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36}\b`)},
+}
+
+// entropyCandidatePattern matches token-like strings that are worth an entropy check
+var entropyCandidatePattern = regexp.MustCompile(`^[A-Za-z0-9+/=_-]+$`)
+
+// noHardcodedSecretsRuleConfig is the config structure for the no_hardcoded_secrets rule
+type noHardcodedSecretsRuleConfig struct {
+	Patterns          []string `hclext:"patterns,optional"`
+	AllowedAttributes []string `hclext:"allowed_attributes,optional"`
+	EntropyThreshold  float64  `hclext:"entropy_threshold,optional"`
+	EntropyMinLength  int      `hclext:"entropy_min_length,optional"`
+}
+
+// NoHardcodedSecretsRule checks whether string literals contain hardcoded secrets
+type NoHardcodedSecretsRule struct {
+	tflint.DefaultRule
+}
+
+// NewNoHardcodedSecretsRule returns a new rule
+func NewNoHardcodedSecretsRule() *NoHardcodedSecretsRule {
+	return &NoHardcodedSecretsRule{}
+}
+
+// Name returns the rule name
+func (r *NoHardcodedSecretsRule) Name() string {
+	return "no_hardcoded_secrets"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NoHardcodedSecretsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *NoHardcodedSecretsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *NoHardcodedSecretsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NoHardcodedSecretsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow hardcoded secrets in attribute values and variable defaults",
+		Tags:        []string{TagSecurity},
+		Config: []ConfigOption{
+			{Name: "patterns", Description: "Additional regular expressions of secret values", Default: "[]"},
+			{Name: "allowed_attributes", Description: "Attribute and variable names that are never reported", Default: "[]"},
+			{Name: "entropy_threshold", Description: "Shannon entropy in bits per character above which strings are reported", Default: "4.5"},
+			{Name: "entropy_min_length", Description: "Minimum length of strings checked for entropy", Default: "20"},
+		},
+		Example: `
+resource "aws_db_instance" "db" {
+  password = "hunter2"
+}
+`,
+	}
+}
+
+// secretScanner holds the compiled configuration for a single Check
+type secretScanner struct {
+	patterns         []*regexp.Regexp
+	allowed          map[string]bool
+	entropyThreshold float64
+	entropyMinLength int
+}
+
+// Check emits issues for attributes, including variable defaults, whose string literals look like secrets
+func (r *NoHardcodedSecretsRule) Check(runner tflint.Runner) error {
+	config := &noHardcodedSecretsRuleConfig{
+		EntropyThreshold: 4.5,
+		EntropyMinLength: 20,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	scanner := &secretScanner{
+		allowed:          make(map[string]bool, len(config.AllowedAttributes)),
+		entropyThreshold: config.EntropyThreshold,
+		entropyMinLength: config.EntropyMinLength,
+	}
+	for _, name := range config.AllowedAttributes {
+		scanner.allowed[name] = true
+	}
+	for _, p := range config.Patterns {
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return fmt.Errorf("invalid secret pattern %q: %w", p, err)
+		}
+		scanner.patterns = append(scanner.patterns, pattern)
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			// Generated JSON files are where leaked credentials often end up, so they are scanned too
+			if err := r.checkJSONBody(runner, scanner, files[name].Body); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := r.checkBody(runner, scanner, body, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *NoHardcodedSecretsRule) checkBody(runner tflint.Runner, scanner *secretScanner, body *hclsyntax.Body, parent *hclsyntax.Block) error {
+	for _, attr := range sortedAttributes(body) {
+		name := attr.Name
+		if parent != nil && parent.Type == "variable" && name == "default" && len(parent.Labels) > 0 {
+			// Variable defaults are judged by the variable name
+			name = parent.Labels[0]
+		}
+		if scanner.allowed[attr.Name] || scanner.allowed[name] {
+			continue
+		}
+
+		secret := isSecretName(name) && (parent == nil || !secretSettingBlocks[parent.Type])
+		if reason := scanner.scan(secret, attr.Expr); reason != "" {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%q %s, pass it in through a sensitive variable or a secret store instead", name, reason),
+				attr.SrcRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, block := range body.Blocks {
+		if err := r.checkBody(runner, scanner, block.Body, block); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkJSONBody scans the string values of a JSON file. Each value is judged by the key holding it,
+// or by the variable name for variable defaults.
+func (r *NoHardcodedSecretsRule) checkJSONBody(runner tflint.Runner, scanner *secretScanner, body hcl.Body) error {
+	for _, str := range jsonStrings(body) {
+		name := str.path[len(str.path)-1]
+		if len(str.path) >= 3 && str.path[0] == "variable" && str.path[2] == "default" {
+			name = str.path[1]
+		}
+		if scanner.allowed[str.path[len(str.path)-1]] || scanner.allowed[name] {
+			continue
+		}
+
+		secret := isSecretName(name) && (len(str.path) < 2 || !secretSettingBlocks[str.path[len(str.path)-2]])
+
+		reason := scanner.scanString(str.value)
+		if secret && str.value != "" {
+			reason = "is set to a hardcoded value"
+		}
+		if reason == "" {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("%q %s, pass it in through a sensitive variable or a secret store instead", name, reason),
+			str.rng,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isSecretName returns whether the attribute or variable name marks its value as a secret
+func isSecretName(name string) bool {
+	return secretNamePattern.MatchString(name) && !secretSettingPattern.MatchString(name)
+}
+
+// scan returns a description of why the expression looks like a secret, or an empty string.
+// secret reports whether the name of the attribute marks its value as a secret.
+func (s *secretScanner) scan(secret bool, expr hclsyntax.Expression) string {
+	if secret {
+		if value, ok := literalString(expr); ok && value != "" {
+			return "is set to a hardcoded value"
+		}
+	}
+
+	var reason string
+	hclsyntax.VisitAll(expr, func(node hclsyntax.Node) hcl.Diagnostics {
+		if reason != "" {
+			return nil
+		}
+		lit, ok := node.(*hclsyntax.LiteralValueExpr)
+		if !ok || lit.Val.Type() != cty.String || lit.Val.IsNull() {
+			return nil
+		}
+		reason = s.scanString(lit.Val.AsString())
+		return nil
+	})
+	return reason
+}
+
+func (s *secretScanner) scanString(value string) string {
+	for _, p := range secretValuePatterns {
+		if p.pattern.MatchString(value) {
+			return fmt.Sprintf("contains what looks like %s", p.description)
+		}
+	}
+	for _, pattern := range s.patterns {
+		if pattern.MatchString(value) {
+			return fmt.Sprintf("contains a value matching the secret pattern %q", pattern)
+		}
+	}
+	if len(value) >= s.entropyMinLength && entropyCandidatePattern.MatchString(value) && shannonEntropy(value) >= s.entropyThreshold {
+		return "contains a high-entropy string that looks like a secret"
+	}
+	return ""
+}
+
+// literalString returns the value of an expression that is a plain string literal without references
+func literalString(expr hcl.Expression) (string, bool) {
+	if len(expr.Variables()) > 0 {
+		return "", false
+	}
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() || val.IsNull() || !val.IsKnown() || val.Type() != cty.String {
+		return "", false
+	}
+	return val.AsString(), true
+}
+
+// shannonEntropy returns the Shannon entropy of the string in bits per character
+func shannonEntropy(value string) float64 {
+	counts := map[rune]int{}
+	length := 0
+	for _, c := range value {
+		counts[c]++
+		length++
+	}
+
+	var entropy float64
+	for _, count := range counts {
+		p := float64(count) / float64(length)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// sortedAttributes returns the attributes of a body in source order
+func sortedAttributes(body *hclsyntax.Body) []*hclsyntax.Attribute {
+	attrs := make([]*hclsyntax.Attribute, 0, len(body.Attributes))
+	for _, attr := range body.Attributes {
+		attrs = append(attrs, attr)
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		return attrs[i].SrcRange.Start.Byte < attrs[j].SrcRange.Start.Byte
+	})
+	return attrs
+}
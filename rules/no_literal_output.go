@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// NoLiteralOutputRule checks whether outputs return a hardcoded literal
+type NoLiteralOutputRule struct {
+	tflint.DefaultRule
+}
+
+// NewNoLiteralOutputRule returns a new rule
+func NewNoLiteralOutputRule() *NoLiteralOutputRule {
+	return &NoLiteralOutputRule{}
+}
+
+// Name returns the rule name
+func (r *NoLiteralOutputRule) Name() string {
+	return "no_literal_output"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NoLiteralOutputRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *NoLiteralOutputRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *NoLiteralOutputRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NoLiteralOutputRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow outputs whose value is a hardcoded string, number or bool",
+		Tags:        []string{TagCorrectness},
+		Example: `
+output "bucket_name" {
+  value = "my-bucket"
+}
+`,
+	}
+}
+
+// Check emits issues for outputs whose value is a constant string, number or bool without references
+func (r *NoLiteralOutputRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "output",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "value"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, output := range body.Blocks {
+		attr, exists := output.Body.Attributes["value"]
+		if !exists || len(attr.Expr.Variables()) > 0 {
+			continue
+		}
+
+		// Function calls cannot be evaluated without a context, so only constant expressions remain
+		val, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() || !val.IsKnown() || val.IsNull() {
+			continue
+		}
+		switch val.Type() {
+		case cty.String, cty.Number, cty.Bool:
+		default:
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("output %q returns a hardcoded value, output a reference to a resource, data source, module or variable instead", output.Labels[0]),
+			attr.Expr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NoLiteralOutputRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "reference",
+			Content: map[string]string{
+				"outputs.tf": `
+output "bucket_name" {
+  value = aws_s3_bucket.this.bucket
+}
+output "url" {
+  value = "https://${aws_s3_bucket.this.bucket_domain_name}"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "function call",
+			Content: map[string]string{
+				"outputs.tf": `
+output "created_at" {
+  value = timestamp()
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "collections and null",
+			Content: map[string]string{
+				"outputs.tf": `
+output "empty" {
+  value = []
+}
+output "nothing" {
+  value = null
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "string literal",
+			Content: map[string]string{
+				"outputs.tf": `
+output "bucket_name" {
+  value = "my-bucket"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoLiteralOutputRule(),
+					Message: `output "bucket_name" returns a hardcoded value, output a reference to a resource, data source, module or variable instead`,
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "number literal",
+			Content: map[string]string{
+				"outputs.tf": `
+output "port" {
+  value = 443
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoLiteralOutputRule(),
+					Message: `output "port" returns a hardcoded value, output a reference to a resource, data source, module or variable instead`,
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 14},
+					},
+				},
+			},
+		},
+		{
+			Name: "bool literal",
+			Content: map[string]string{
+				"outputs.tf": `
+output "enabled" {
+  value = true
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoLiteralOutputRule(),
+					Message: `output "enabled" returns a hardcoded value, output a reference to a resource, data source, module or variable instead`,
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 15},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewNoLiteralOutputRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package rules
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// noModuleTfvarsRuleConfig is the config structure for the no_module_tfvars rule
+type noModuleTfvarsRuleConfig struct {
+	Heuristic    string   `hclext:"heuristic,optional"`
+	ModulePaths  []string `hclext:"module_paths,optional"`
+	ForbidInRoot bool     `hclext:"forbid_in_root,optional"`
+}
+
+// NoModuleTfvarsRule checks whether modules contain variable definitions files that Terraform loads automatically
+type NoModuleTfvarsRule struct {
+	tflint.DefaultRule
+}
+
+// NewNoModuleTfvarsRule returns a new rule
+func NewNoModuleTfvarsRule() *NoModuleTfvarsRule {
+	return &NoModuleTfvarsRule{}
+}
+
+// Name returns the rule name
+func (r *NoModuleTfvarsRule) Name() string {
+	return "no_module_tfvars"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NoModuleTfvarsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *NoModuleTfvarsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *NoModuleTfvarsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NoModuleTfvarsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow `terraform.tfvars` and `*.auto.tfvars` files in reusable modules",
+		Tags:        []string{TagStructure},
+		Config: []ConfigOption{
+			{Name: "heuristic", Description: "How reusable modules are detected: backend or module_path", Default: "backend"},
+			{Name: "module_paths", Description: "Glob patterns of reusable module directories for the module_path heuristic, relative to the root of the git repository, or to the working directory outside one", Default: `["modules/**"]`},
+			{Name: "forbid_in_root", Description: "Also report variable definitions files in root modules, for example when variables come from Terraform Cloud variable sets", Default: "false"},
+		},
+		Example: `
+# A module without a backend block that contains terraform.tfvars
+`,
+	}
+}
+
+// Check emits an issue for every automatically loaded variable definitions file in the module directory.
+// Module calls ignore these files, so in a reusable module they only suggest values that are never used.
+func (r *NoModuleTfvarsRule) Check(runner tflint.Runner) error {
+	config := &noModuleTfvarsRuleConfig{
+		Heuristic:   reusableModuleHeuristicBackend,
+		ModulePaths: defaultReusableModulePaths,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	reusable, err := isReusableModule(runner, config.Heuristic, config.ModulePaths)
+	if err != nil {
+		return err
+	}
+	if !reusable && !config.ForbidInRoot {
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	// Variable definitions files are not served by the runner, so they are listed from the filesystem
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && isAutoLoadedTfvars(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	message := "%s is ignored when this module is called, set the values in the calling module instead"
+	if !reusable {
+		message = "%s should not be committed, manage variable values outside the repository instead"
+	}
+
+	for _, name := range names {
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf(message, name),
+			hcl.Range{
+				Filename: filepath.Join(dir, name),
+				Start:    hcl.InitialPos,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isAutoLoadedTfvars returns whether Terraform loads the variable definitions file without a -var-file flag
+func isAutoLoadedTfvars(name string) bool {
+	switch name {
+	case "terraform.tfvars", "terraform.tfvars.json":
+		return true
+	}
+	return strings.HasSuffix(name, ".auto.tfvars") || strings.HasSuffix(name, ".auto.tfvars.json")
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NoModuleTfvarsRule(t *testing.T) {
+	root := `
+terraform {
+  backend "s3" {}
+}
+`
+
+	cases := []struct {
+		Name     string
+		Config   string
+		Main     string
+		Files    map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name:     "no tfvars",
+			Files:    map[string]string{"README.md": "# Module"},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "tfvars in reusable module",
+			Files: map[string]string{
+				"terraform.tfvars":         `region = "us-east-1"`,
+				"prod.auto.tfvars.json":    `{"region": "us-east-1"}`,
+				"examples/prod.tfvars":     `region = "us-east-1"`,
+				"terraform.tfvars.example": `region = "us-east-1"`,
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewNoModuleTfvarsRule(),
+						Message: "prod.auto.tfvars.json is ignored when this module is called, set the values in the calling module instead",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "prod.auto.tfvars.json"),
+							Start:    hcl.InitialPos,
+						},
+					},
+					{
+						Rule:    NewNoModuleTfvarsRule(),
+						Message: "terraform.tfvars is ignored when this module is called, set the values in the calling module instead",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "terraform.tfvars"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+		{
+			Name:     "tfvars in root module",
+			Main:     root,
+			Files:    map[string]string{"terraform.tfvars": `region = "us-east-1"`},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "tfvars forbidden in root module",
+			Config: `
+rule "no_module_tfvars" {
+  enabled        = true
+  forbid_in_root = true
+}
+`,
+			Main:  root,
+			Files: map[string]string{"terraform.tfvars": `region = "us-east-1"`},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewNoModuleTfvarsRule(),
+						Message: "terraform.tfvars should not be committed, manage variable values outside the repository instead",
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "terraform.tfvars"),
+							Start:    hcl.InitialPos,
+						},
+					},
+				}
+			},
+		},
+	}
+
+	rule := NewNoModuleTfvarsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.Files)
+
+			content := map[string]string{filepath.Join(dir, "main.tf"): tc.Main}
+			if tc.Config != "" {
+				content[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// noPassthroughOutputRuleConfig is the config structure for the no_passthrough_output rule
+type noPassthroughOutputRuleConfig struct {
+	ExemptOutputs []string `hclext:"exempt_outputs,optional"`
+	ExemptModules []string `hclext:"exempt_modules,optional"`
+}
+
+// NoPassthroughOutputRule checks whether outputs only re-export a variable
+type NoPassthroughOutputRule struct {
+	tflint.DefaultRule
+}
+
+// NewNoPassthroughOutputRule returns a new rule
+func NewNoPassthroughOutputRule() *NoPassthroughOutputRule {
+	return &NoPassthroughOutputRule{}
+}
+
+// Name returns the rule name
+func (r *NoPassthroughOutputRule) Name() string {
+	return "no_passthrough_output"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NoPassthroughOutputRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *NoPassthroughOutputRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *NoPassthroughOutputRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NoPassthroughOutputRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow outputs that only re-export an input variable",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "exempt_outputs", Description: "Output names that may re-export a variable", Default: "[]"},
+			{Name: "exempt_modules", Description: "Path globs of module directories that are intentional passthrough modules", Default: "[]"},
+		},
+		Example: `
+output "region" {
+  value = var.region
+}
+`,
+	}
+}
+
+// Check emits issues for outputs whose value is exactly a single var.<name> reference
+func (r *NoPassthroughOutputRule) Check(runner tflint.Runner) error {
+	config := &noPassthroughOutputRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	exemptOutputs := make(map[string]bool, len(config.ExemptOutputs))
+	for _, name := range config.ExemptOutputs {
+		exemptOutputs[name] = true
+	}
+	exemptModules := make([]*regexp.Regexp, 0, len(config.ExemptModules))
+	for _, glob := range config.ExemptModules {
+		pattern, err := compileGlob(glob)
+		if err != nil {
+			return fmt.Errorf("invalid exempt_modules glob %q: %w", glob, err)
+		}
+		exemptModules = append(exemptModules, pattern)
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "output",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "value"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, output := range body.Blocks {
+		name := output.Labels[0]
+		if exemptOutputs[name] {
+			continue
+		}
+		dir := filepath.ToSlash(filepath.Dir(output.DefRange.Filename))
+		if matchesAny(exemptModules, dir) {
+			continue
+		}
+
+		attr, exists := output.Body.Attributes["value"]
+		if !exists {
+			continue
+		}
+		variable, ok := passthroughVariable(attr.Expr)
+		if !ok {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("output %q only re-exports var.%s, the caller already has this value", name, variable),
+			attr.Expr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// passthroughVariable returns the variable name if the expression is exactly var.<name>, optionally interpolation-only
+func passthroughVariable(expr hcl.Expression) (string, bool) {
+	if wrap, ok := expr.(*hclsyntax.TemplateWrapExpr); ok {
+		expr = wrap.Wrapped
+	}
+	traversal, ok := expr.(*hclsyntax.ScopeTraversalExpr)
+	if !ok || len(traversal.Traversal) != 2 || traversal.Traversal.RootName() != "var" {
+		return "", false
+	}
+	attr, ok := traversal.Traversal[1].(hcl.TraverseAttr)
+	if !ok {
+		return "", false
+	}
+	return attr.Name, true
+}
@@ -0,0 +1,124 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NoPassthroughOutputRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "derived values",
+			Content: map[string]string{
+				"outputs.tf": `
+output "bucket_arn" {
+  value = aws_s3_bucket.this.arn
+}
+output "name" {
+  value = "${var.prefix}-app"
+}
+output "zone" {
+  value = var.settings.zone
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "passthrough",
+			Content: map[string]string{
+				"outputs.tf": `
+output "region" {
+  value = var.region
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoPassthroughOutputRule(),
+					Message: `output "region" only re-exports var.region, the caller already has this value`,
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "interpolation-only passthrough",
+			Content: map[string]string{
+				"outputs.tf": `
+output "region" {
+  value = "${var.region}"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoPassthroughOutputRule(),
+					Message: `output "region" only re-exports var.region, the caller already has this value`,
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "exempt outputs",
+			Content: map[string]string{
+				"outputs.tf": `
+output "region" {
+  value = var.region
+}
+`,
+				".tflint.hcl": `
+rule "no_passthrough_output" {
+  enabled        = true
+  exempt_outputs = ["region"]
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "exempt modules",
+			Content: map[string]string{
+				"modules/config/outputs.tf": `
+output "region" {
+  value = var.region
+}
+`,
+				".tflint.hcl": `
+rule "no_passthrough_output" {
+  enabled        = true
+  exempt_modules = ["modules/config"]
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewNoPassthroughOutputRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// noPerpetualDiffFunctionsRuleConfig is the config structure for the no_perpetual_diff_functions rule
+type noPerpetualDiffFunctionsRuleConfig struct {
+	Functions []string `hclext:"functions,optional"`
+}
+
+// NoPerpetualDiffFunctionsRule checks whether resource arguments call functions that return a new value on every plan
+type NoPerpetualDiffFunctionsRule struct {
+	tflint.DefaultRule
+}
+
+// NewNoPerpetualDiffFunctionsRule returns a new rule
+func NewNoPerpetualDiffFunctionsRule() *NoPerpetualDiffFunctionsRule {
+	return &NoPerpetualDiffFunctionsRule{}
+}
+
+// Name returns the rule name
+func (r *NoPerpetualDiffFunctionsRule) Name() string {
+	return "no_perpetual_diff_functions"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NoPerpetualDiffFunctionsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *NoPerpetualDiffFunctionsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *NoPerpetualDiffFunctionsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NoPerpetualDiffFunctionsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow functions such as uuid() and timestamp() in resource arguments that are not in ignore_changes",
+		Tags:        []string{TagCorrectness},
+		Config: []ConfigOption{
+			{Name: "functions", Description: "Functions that return a new value on every plan", Default: `["uuid", "timestamp", "bcrypt"]`},
+		},
+		Example: `
+resource "aws_instance" "web" {
+  tags = {
+    CreatedAt = timestamp()
+  }
+}
+`,
+	}
+}
+
+// Check emits an issue for every call to one of the functions in a resource argument, including arguments of
+// nested blocks. Arguments listed in lifecycle.ignore_changes, and resources that ignore all changes, are skipped.
+func (r *NoPerpetualDiffFunctionsRule) Check(runner tflint.Runner) error {
+	config := &noPerpetualDiffFunctionsRuleConfig{
+		Functions: []string{"uuid", "timestamp", "bcrypt"},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	functions := make(map[string]bool, len(config.Functions))
+	for _, name := range config.Functions {
+		functions[name] = true
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			// JSON files are likely generated
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) != 2 {
+				continue
+			}
+			ignored, all := ignoredChanges(block.Body)
+			if all {
+				continue
+			}
+			if err := r.checkBody(runner, functions, block, block.Body, ignored); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *NoPerpetualDiffFunctionsRule) checkBody(runner tflint.Runner, functions map[string]bool, resource *hclsyntax.Block, body *hclsyntax.Body, ignored map[string]bool) error {
+	for _, attr := range sortedAttributes(body) {
+		if ignored[attr.Name] {
+			continue
+		}
+
+		calls := []*hclsyntax.FunctionCallExpr{}
+		hclsyntax.VisitAll(attr.Expr, func(node hclsyntax.Node) hcl.Diagnostics {
+			if call, ok := node.(*hclsyntax.FunctionCallExpr); ok && functions[call.Name] {
+				calls = append(calls, call)
+			}
+			return nil
+		})
+		for _, call := range calls {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf(
+					`%s() in resource "%s" "%s" returns a new value on every plan, so the resource always shows a diff; add %q to lifecycle.ignore_changes or compute the value outside Terraform`,
+					call.Name,
+					resource.Labels[0],
+					resource.Labels[1],
+					attr.Name,
+				),
+				call.Range(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, block := range body.Blocks {
+		if ignored[block.Type] || block.Type == "lifecycle" || block.Type == "provisioner" || block.Type == "connection" {
+			continue
+		}
+		// Arguments of nested blocks are only ignored through the block name
+		if err := r.checkBody(runner, functions, resource, block.Body, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ignoredChanges returns the top-level argument and block names listed in lifecycle.ignore_changes of a
+// resource body, and whether all changes are ignored
+func ignoredChanges(body *hclsyntax.Body) (map[string]bool, bool) {
+	ignored := map[string]bool{}
+	for _, block := range body.Blocks {
+		if block.Type != "lifecycle" {
+			continue
+		}
+		attr, exists := block.Body.Attributes["ignore_changes"]
+		if !exists {
+			continue
+		}
+		if hcl.ExprAsKeyword(attr.Expr) == "all" {
+			return ignored, true
+		}
+		exprs, diags := hcl.ExprList(attr.Expr)
+		if diags.HasErrors() {
+			continue
+		}
+		for _, expr := range exprs {
+			traversal, diags := hcl.AbsTraversalForExpr(expr)
+			if diags.HasErrors() {
+				continue
+			}
+			ignored[traversal.RootName()] = true
+		}
+	}
+	return ignored, false
+}
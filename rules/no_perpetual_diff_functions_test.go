@@ -0,0 +1,147 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NoPerpetualDiffFunctionsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no calls",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  ami = "ami-12345678"
+}
+locals {
+  created_at = timestamp()
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "ignored changes",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  tags = {
+    CreatedAt = timestamp()
+  }
+  ebs_block_device {
+    tags = { Id = uuid() }
+  }
+  lifecycle {
+    ignore_changes = [tags, ebs_block_device]
+  }
+}
+resource "random_password" "db" {
+  keepers = { id = uuid() }
+  lifecycle {
+    ignore_changes = all
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "argument",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  tags = {
+    CreatedAt = timestamp()
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoPerpetualDiffFunctionsRule(),
+					Message: `timestamp() in resource "aws_instance" "web" returns a new value on every plan, so the resource always shows a diff; add "tags" to lifecycle.ignore_changes or compute the value outside Terraform`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 17},
+						End:      hcl.Pos{Line: 4, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "nested block",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  ebs_block_device {
+    device_name = "sdb-${uuid()}"
+  }
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoPerpetualDiffFunctionsRule(),
+					Message: `uuid() in resource "aws_instance" "web" returns a new value on every plan, so the resource always shows a diff; add "device_name" to lifecycle.ignore_changes or compute the value outside Terraform`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 26},
+						End:      hcl.Pos{Line: 4, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom functions",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  user_data = plantimestamp()
+  tags      = { CreatedAt = timestamp() }
+}
+`,
+				".tflint.hcl": `
+rule "no_perpetual_diff_functions" {
+  enabled   = true
+  functions = ["plantimestamp"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoPerpetualDiffFunctionsRule(),
+					Message: `plantimestamp() in resource "aws_instance" "web" returns a new value on every plan, so the resource always shows a diff; add "user_data" to lifecycle.ignore_changes or compute the value outside Terraform`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 15},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewNoPerpetualDiffFunctionsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
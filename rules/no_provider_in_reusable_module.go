@@ -0,0 +1,179 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const (
+	// reusableModuleHeuristicBackend treats modules without a backend or cloud block as reusable
+	reusableModuleHeuristicBackend = "backend"
+	// reusableModuleHeuristicModulePath treats modules whose directory matches module_paths as reusable
+	reusableModuleHeuristicModulePath = "module_path"
+)
+
+// defaultReusableModulePaths are the directories the module_path heuristic treats as reusable modules by default
+var defaultReusableModulePaths = []string{"modules/**"}
+
+// noProviderInReusableModuleRuleConfig is the config structure for the no_provider_in_reusable_module rule
+type noProviderInReusableModuleRuleConfig struct {
+	Heuristic   string   `hclext:"heuristic,optional"`
+	ModulePaths []string `hclext:"module_paths,optional"`
+}
+
+// NoProviderInReusableModuleRule checks whether reusable modules configure providers themselves
+type NoProviderInReusableModuleRule struct {
+	tflint.DefaultRule
+}
+
+// NewNoProviderInReusableModuleRule returns a new rule
+func NewNoProviderInReusableModuleRule() *NoProviderInReusableModuleRule {
+	return &NoProviderInReusableModuleRule{}
+}
+
+// Name returns the rule name
+func (r *NoProviderInReusableModuleRule) Name() string {
+	return "no_provider_in_reusable_module"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NoProviderInReusableModuleRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *NoProviderInReusableModuleRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *NoProviderInReusableModuleRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NoProviderInReusableModuleRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow provider configurations in reusable modules",
+		Tags:        []string{TagCorrectness},
+		Config: []ConfigOption{
+			{Name: "heuristic", Description: "How reusable modules are detected: backend or module_path", Default: "backend"},
+			{Name: "module_paths", Description: "Glob patterns of reusable module directories for the module_path heuristic, relative to the root of the git repository, or to the working directory outside one", Default: `["modules/**"]`},
+		},
+		Example: `
+# A module without a backend block
+provider "aws" {
+  region = "us-east-1"
+}
+`,
+	}
+}
+
+// Check emits issues for provider blocks in modules that look reusable
+func (r *NoProviderInReusableModuleRule) Check(runner tflint.Runner) error {
+	config := &noProviderInReusableModuleRuleConfig{
+		Heuristic:   reusableModuleHeuristicBackend,
+		ModulePaths: defaultReusableModulePaths,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// TFLint drops issues in called modules that are not tied to module arguments,
+		// so reusable modules are inspected when they are linted themselves, as with --recursive.
+		return nil
+	}
+
+	reusable, err := isReusableModule(runner, config.Heuristic, config.ModulePaths)
+	if err != nil {
+		return err
+	}
+	if !reusable {
+		return nil
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "provider",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, provider := range body.Blocks {
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("provider %q should not be configured in a reusable module, pass it from the caller instead", provider.Labels[0]),
+			provider.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isReusableModule returns whether the module looks reusable rather than a root configuration, using the heuristic
+// With the module_path heuristic, modulePaths are globs matched against the directory relative to the repository root.
+func isReusableModule(runner tflint.Runner, heuristic string, modulePaths []string) (bool, error) {
+	switch heuristic {
+	case reusableModuleHeuristicBackend:
+		body, err := runner.GetModuleContent(&hclext.BodySchema{
+			Blocks: []hclext.BlockSchema{
+				{
+					Type: "terraform",
+					Body: &hclext.BodySchema{
+						Blocks: []hclext.BlockSchema{
+							{Type: "backend", LabelNames: []string{"type"}, Body: &hclext.BodySchema{}},
+							{Type: "cloud", Body: &hclext.BodySchema{}},
+						},
+					},
+				},
+			},
+		}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+		if err != nil {
+			return false, err
+		}
+		for _, terraform := range body.Blocks {
+			if len(terraform.Body.Blocks) > 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	case reusableModuleHeuristicModulePath:
+		patterns := make([]*regexp.Regexp, 0, len(modulePaths))
+		for _, glob := range modulePaths {
+			pattern, err := compileGlob(glob)
+			if err != nil {
+				return false, fmt.Errorf("invalid module path pattern %q: %w", glob, err)
+			}
+			patterns = append(patterns, pattern)
+		}
+
+		dir, files, err := moduleFiles(runner)
+		if err != nil || len(files) == 0 {
+			return false, err
+		}
+		rel, err := repositoryPath(dir)
+		if err != nil {
+			return false, err
+		}
+		return matchesAny(patterns, rel), nil
+	default:
+		return false, fmt.Errorf("%q is an invalid heuristic. Valid values are %s and %s", heuristic, reusableModuleHeuristicBackend, reusableModuleHeuristicModulePath)
+	}
+}
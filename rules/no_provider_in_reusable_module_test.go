@@ -0,0 +1,142 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NoProviderInReusableModuleRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "root module with backend",
+			Content: map[string]string{
+				"main.tf": `
+terraform {
+  backend "s3" {}
+}
+provider "aws" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "root module with cloud",
+			Content: map[string]string{
+				"main.tf": `
+terraform {
+  cloud {}
+}
+provider "aws" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "reusable module",
+			Content: map[string]string{
+				"main.tf": `
+provider "aws" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoProviderInReusableModuleRule(),
+					Message: `provider "aws" should not be configured in a reusable module, pass it from the caller instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 15},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewNoProviderInReusableModuleRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_NoProviderInReusableModuleRule_ModulePath(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Dir      string
+		Config   string
+		Expected func(filename string) helper.Issues
+	}{
+		{
+			Name:     "root module",
+			Dir:      "environments/prod",
+			Expected: func(filename string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "module directory",
+			Dir:  "modules/network",
+			Expected: func(filename string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewNoProviderInReusableModuleRule(),
+						Message: `provider "aws" should not be configured in a reusable module, pass it from the caller instead`,
+						Range: hcl.Range{
+							Filename: filename,
+							Start:    hcl.Pos{Line: 2, Column: 1},
+							End:      hcl.Pos{Line: 2, Column: 15},
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "custom module paths",
+			Dir:  "modules/network",
+			Config: `
+  module_paths = ["platform/*/modules/*"]`,
+			Expected: func(filename string) helper.Issues { return helper.Issues{} },
+		},
+	}
+
+	rule := NewNoProviderInReusableModuleRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			root := t.TempDir()
+			writeFiles(t, root, map[string]string{".git/HEAD": ""})
+
+			filename := filepath.Join(root, tc.Dir, "main.tf")
+			runner := helper.TestRunner(t, map[string]string{
+				filename: `
+provider "aws" {}
+`,
+				".tflint.hcl": `
+rule "no_provider_in_reusable_module" {
+  enabled   = true
+  heuristic = "module_path"` + tc.Config + `
+}
+`,
+			})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(filename), runner.Issues)
+		})
+	}
+}
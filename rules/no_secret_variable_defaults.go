@@ -0,0 +1,161 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// noSecretVariableDefaultsRuleConfig is the config structure for the no_secret_variable_defaults rule
+type noSecretVariableDefaultsRuleConfig struct {
+	Patterns []string `hclext:"patterns,optional"`
+}
+
+// NoSecretVariableDefaultsRule checks whether sensitive variables declare a default value
+type NoSecretVariableDefaultsRule struct {
+	tflint.DefaultRule
+}
+
+// NewNoSecretVariableDefaultsRule returns a new rule
+func NewNoSecretVariableDefaultsRule() *NoSecretVariableDefaultsRule {
+	return &NoSecretVariableDefaultsRule{}
+}
+
+// Name returns the rule name
+func (r *NoSecretVariableDefaultsRule) Name() string {
+	return "no_secret_variable_defaults"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NoSecretVariableDefaultsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *NoSecretVariableDefaultsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *NoSecretVariableDefaultsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NoSecretVariableDefaultsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow non-empty defaults on sensitive variables and variables whose names look like secrets",
+		Tags:        []string{TagSecurity},
+		Config: []ConfigOption{
+			{Name: "patterns", Description: "Regular expressions of variable names that hold secrets. Empty uses the same names as no_hardcoded_secrets", Default: "[]"},
+		},
+		Example: `
+variable "db_password" {
+  type      = string
+  default   = "hunter2"
+  sensitive = true
+}
+`,
+	}
+}
+
+// Check emits issues for variables marked sensitive, or whose names match a secret pattern, that declare a
+// default other than null or an empty value, since defaults are committed to VCS and end up in state.
+func (r *NoSecretVariableDefaultsRule) Check(runner tflint.Runner) error {
+	config := &noSecretVariableDefaultsRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	patterns := []*regexp.Regexp{secretNamePattern}
+	if len(config.Patterns) > 0 {
+		patterns = make([]*regexp.Regexp, len(config.Patterns))
+		for i, p := range config.Patterns {
+			pattern, err := regexp.Compile(p)
+			if err != nil {
+				return fmt.Errorf("invalid pattern %q: %w", p, err)
+			}
+			patterns[i] = pattern
+		}
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "default"}, {Name: "sensitive"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		name := variable.Labels[0]
+		def, exists := variable.Body.Attributes["default"]
+		if !exists {
+			continue
+		}
+
+		// Variable defaults and the sensitive flag must be literal values
+		value, diags := def.Expr.Value(nil)
+		if !diags.HasErrors() && emptyValue(value) {
+			continue
+		}
+
+		reason := ""
+		if attr, exists := variable.Body.Attributes["sensitive"]; exists {
+			if sensitive, diags := attr.Expr.Value(nil); !diags.HasErrors() && sensitive.Type() == cty.Bool && sensitive.IsKnown() && !sensitive.IsNull() && sensitive.True() {
+				reason = "is marked sensitive"
+			}
+		}
+		if reason == "" {
+			for _, pattern := range patterns {
+				if pattern.MatchString(name) {
+					reason = "looks like a secret"
+					break
+				}
+			}
+		}
+		if reason == "" {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("variable %q %s and must not have a default, defaults are committed to version control and stored in state", name, reason),
+			def.Range,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// emptyValue returns whether a value is null, an empty string or an empty collection
+func emptyValue(value cty.Value) bool {
+	if value.IsNull() {
+		return true
+	}
+	if !value.IsWhollyKnown() {
+		return false
+	}
+	ty := value.Type()
+	switch {
+	case ty == cty.String:
+		return value.AsString() == ""
+	case ty.IsListType(), ty.IsSetType(), ty.IsMapType(), ty.IsTupleType(), ty.IsObjectType():
+		return value.LengthInt() == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NoSecretVariableDefaultsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "empty defaults",
+			Content: `
+variable "db_password" {
+  type      = string
+  default   = null
+  sensitive = true
+}
+variable "api_token" {
+  type    = string
+  default = ""
+}
+variable "credentials" {
+  type      = map(string)
+  default   = {}
+  sensitive = true
+}
+variable "instance_type" {
+  type    = string
+  default = "t3.micro"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "sensitive variable",
+			Content: `
+variable "credentials" {
+  type      = map(string)
+  default   = { user = "admin" }
+  sensitive = true
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoSecretVariableDefaultsRule(),
+					Message: `variable "credentials" is marked sensitive and must not have a default, defaults are committed to version control and stored in state`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "secret name",
+			Content: `
+variable "db_password" {
+  type      = string
+  default   = "hunter2"
+  sensitive = false
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoSecretVariableDefaultsRule(),
+					Message: `variable "db_password" looks like a secret and must not have a default, defaults are committed to version control and stored in state`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom patterns",
+			Content: `
+variable "db_password" {
+  type    = string
+  default = "hunter2"
+}
+variable "license" {
+  type    = string
+  default = "ABCD-1234"
+}
+`,
+			Config: `
+rule "no_secret_variable_defaults" {
+  enabled  = true
+  patterns = ["^license$"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoSecretVariableDefaultsRule(),
+					Message: `variable "license" looks like a secret and must not have a default, defaults are committed to version control and stored in state`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 8, Column: 3},
+						End:      hcl.Pos{Line: 8, Column: 24},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewNoSecretVariableDefaultsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"variables.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
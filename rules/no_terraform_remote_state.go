@@ -0,0 +1,149 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// noTerraformRemoteStateRuleConfig is the config structure for the no_terraform_remote_state rule
+type noTerraformRemoteStateRuleConfig struct {
+	AllowedKeys []string `hclext:"allowed_keys,optional"`
+}
+
+// NoTerraformRemoteStateRule checks whether terraform_remote_state data sources are used
+type NoTerraformRemoteStateRule struct {
+	tflint.DefaultRule
+}
+
+// NewNoTerraformRemoteStateRule returns a new rule
+func NewNoTerraformRemoteStateRule() *NoTerraformRemoteStateRule {
+	return &NoTerraformRemoteStateRule{}
+}
+
+// Name returns the rule name
+func (r *NoTerraformRemoteStateRule) Name() string {
+	return "no_terraform_remote_state"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *NoTerraformRemoteStateRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *NoTerraformRemoteStateRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *NoTerraformRemoteStateRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *NoTerraformRemoteStateRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow terraform_remote_state data sources in favor of input variables or data lookups",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "allowed_keys", Description: "Globs of the state keys, or prefixes for the gcs backend, that may be read", Default: "[]"},
+		},
+		Example: `
+data "terraform_remote_state" "network" {
+  backend = "s3"
+  config = {
+    bucket = "state"
+    key    = "network/terraform.tfstate"
+  }
+}
+`,
+	}
+}
+
+// Check emits an issue for every terraform_remote_state data source, unless the key or prefix of its config
+// is statically known and matches one of the allowed keys
+func (r *NoTerraformRemoteStateRule) Check(runner tflint.Runner) error {
+	config := &noTerraformRemoteStateRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	allowed := make([]*regexp.Regexp, 0, len(config.AllowedKeys))
+	for _, glob := range config.AllowedKeys {
+		pattern, err := compileGlob(glob)
+		if err != nil {
+			return fmt.Errorf("invalid allowed_keys glob %q: %w", glob, err)
+		}
+		allowed = append(allowed, pattern)
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "data",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "config"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	evaluate := runnerEvaluator(runner)
+	for _, data := range body.Blocks {
+		if data.Labels[0] != "terraform_remote_state" {
+			continue
+		}
+
+		key := ""
+		if attr, exists := data.Body.Attributes["config"]; exists && len(allowed) > 0 {
+			value, err := evaluate(attr.Expr)
+			if err != nil {
+				return err
+			}
+			key = remoteStateKey(value)
+		}
+		if key != "" && matchesAny(allowed, key) {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf(`data "terraform_remote_state" %q couples this module to another state, pass the values in as variables or look them up with data sources instead`, data.Labels[1]),
+			data.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// remoteStateKey returns the key, or the prefix for the gcs backend, of a known terraform_remote_state config
+func remoteStateKey(config cty.Value) string {
+	if !config.IsWhollyKnown() || config.IsNull() || !(config.Type().IsObjectType() || config.Type().IsMapType()) {
+		return ""
+	}
+	for _, name := range []string{"key", "prefix"} {
+		var value cty.Value
+		switch {
+		case config.Type().IsObjectType() && config.Type().HasAttribute(name):
+			value = config.GetAttr(name)
+		case config.Type().IsMapType() && config.HasIndex(cty.StringVal(name)).True():
+			value = config.Index(cty.StringVal(name))
+		default:
+			continue
+		}
+		if value.Type() == cty.String && !value.IsNull() {
+			return value.AsString()
+		}
+	}
+	return ""
+}
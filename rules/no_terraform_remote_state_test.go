@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_NoTerraformRemoteStateRule(t *testing.T) {
+	config := `
+rule "no_terraform_remote_state" {
+  enabled      = true
+  allowed_keys = ["shared/**"]
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "other data sources",
+			Content: map[string]string{
+				"main.tf": `
+data "aws_vpc" "main" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "remote state",
+			Content: map[string]string{
+				"main.tf": `
+data "terraform_remote_state" "network" {
+  backend = "s3"
+  config = {
+    bucket = "state"
+    key    = "network/terraform.tfstate"
+  }
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoTerraformRemoteStateRule(),
+					Message: `data "terraform_remote_state" "network" couples this module to another state, pass the values in as variables or look them up with data sources instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 40},
+					},
+				},
+			},
+		},
+		{
+			Name: "allowed key",
+			Content: map[string]string{
+				"main.tf": `
+data "terraform_remote_state" "dns" {
+  backend = "gcs"
+  config = {
+    bucket = "state"
+    prefix = "shared/dns"
+  }
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unknown key",
+			Content: map[string]string{
+				"main.tf": `
+variable "key" {}
+data "terraform_remote_state" "dns" {
+  backend = "s3"
+  config = {
+    bucket = "state"
+    key    = var.key
+  }
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewNoTerraformRemoteStateRule(),
+					Message: `data "terraform_remote_state" "dns" couples this module to another state, pass the values in as variables or look them up with data sources instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 36},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewNoTerraformRemoteStateRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// outputDescriptionRequiredRuleConfig is the config structure for the output_description_required rule
+type outputDescriptionRequiredRuleConfig struct {
+	Exclude string `hclext:"exclude,optional"`
+}
+
+// OutputDescriptionRequiredRule checks whether outputs have a description
+type OutputDescriptionRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewOutputDescriptionRequiredRule returns a new rule
+func NewOutputDescriptionRequiredRule() *OutputDescriptionRequiredRule {
+	return &OutputDescriptionRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *OutputDescriptionRequiredRule) Name() string {
+	return "output_description_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OutputDescriptionRequiredRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OutputDescriptionRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *OutputDescriptionRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *OutputDescriptionRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require a non-empty description on every output",
+		Tags:        []string{TagDocumentation},
+		Config: []ConfigOption{
+			{Name: "exclude", Description: "Regular expression of output names to skip", Default: ""},
+		},
+		Example: `
+output "bucket_arn" {
+  value = aws_s3_bucket.this.arn
+}
+`,
+	}
+}
+
+// Check emits issues for outputs without a non-empty description, except those matching the exclude pattern
+func (r *OutputDescriptionRequiredRule) Check(runner tflint.Runner) error {
+	config := &outputDescriptionRequiredRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	var exclude *regexp.Regexp
+	if config.Exclude != "" {
+		var err error
+		exclude, err = regexp.Compile(config.Exclude)
+		if err != nil {
+			return fmt.Errorf("invalid exclude pattern %q: %w", config.Exclude, err)
+		}
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "output",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "description"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, output := range body.Blocks {
+		name := output.Labels[0]
+		if exclude != nil && exclude.MatchString(name) {
+			continue
+		}
+
+		attr, exists := output.Body.Attributes["description"]
+		if !exists {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("output %q should have a description", name),
+				output.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(description string) error {
+			if strings.TrimSpace(description) != "" {
+				return nil
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("output %q should have a non-empty description", name),
+				attr.Range,
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OutputDescriptionRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "with description",
+			Content: map[string]string{
+				"outputs.tf": `
+output "o" {
+  value       = null
+  description = "The value"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing description",
+			Content: map[string]string{
+				"outputs.tf": `
+output "o" {
+  value = null
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewOutputDescriptionRequiredRule(),
+					Message: `output "o" should have a description`,
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 11},
+					},
+				},
+			},
+		},
+		{
+			Name: "empty description",
+			Content: map[string]string{
+				"outputs.tf": `
+output "o" {
+  value       = null
+  description = ""
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewOutputDescriptionRequiredRule(),
+					Message: `output "o" should have a non-empty description`,
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name: "excluded output",
+			Content: map[string]string{
+				"outputs.tf": `
+output "debug_o" {
+  value = null
+}
+`,
+				".tflint.hcl": `
+rule "output_description_required" {
+  enabled = true
+  exclude = "^debug_"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOutputDescriptionRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
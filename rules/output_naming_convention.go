@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/internal/naming"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// outputNamingConventionRuleConfig is the config structure for the output_naming_convention rule
+type outputNamingConventionRuleConfig struct {
+	Format       string   `hclext:"format,optional"`
+	Custom       string   `hclext:"custom,optional"`
+	AllowedNames []string `hclext:"allowed_names,optional"`
+}
+
+// OutputNamingConventionRule checks whether output names follow a naming convention
+type OutputNamingConventionRule struct {
+	tflint.DefaultRule
+}
+
+// NewOutputNamingConventionRule returns a new rule
+func NewOutputNamingConventionRule() *OutputNamingConventionRule {
+	return &OutputNamingConventionRule{}
+}
+
+// Name returns the rule name
+func (r *OutputNamingConventionRule) Name() string {
+	return "output_naming_convention"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OutputNamingConventionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OutputNamingConventionRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *OutputNamingConventionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *OutputNamingConventionRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Enforce a naming convention on output names",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "format", Description: "snake_case, camelCase, PascalCase, kebab-case or custom", Default: "snake_case"},
+			{Name: "custom", Description: "Regular expression used when format is custom", Default: ""},
+			{Name: "allowed_names", Description: "Output names that are always accepted", Default: "[]"},
+		},
+		Example: `
+output "bucketArn" {
+  value = aws_s3_bucket.this.arn
+}
+`,
+	}
+}
+
+// Check emits issues for output names that do not match the configured format, except allowed names
+func (r *OutputNamingConventionRule) Check(runner tflint.Runner) error {
+	format, custom := namingDefaults(runner)
+	config := &outputNamingConventionRuleConfig{Format: format, Custom: custom}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	convention, err := naming.New(config.Format, config.Custom)
+	if err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "output",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool, len(config.AllowedNames))
+	for _, name := range config.AllowedNames {
+		allowed[name] = true
+	}
+
+	for _, output := range body.Blocks {
+		name := output.Labels[0]
+		if convention.Match(name) || allowed[name] {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("output name %q must match the following %s", name, convention),
+			output.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
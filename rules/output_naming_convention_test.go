@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OutputNamingConventionRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "snake_case by default",
+			Content: map[string]string{
+				"outputs.tf": `
+output "instance_id" { value = null }
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "camelCase violates snake_case",
+			Content: map[string]string{
+				"outputs.tf": `
+output "instanceId" { value = null }
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewOutputNamingConventionRule(),
+					Message: `output name "instanceId" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "allowed names",
+			Content: map[string]string{
+				"outputs.tf": `
+output "legacyId" { value = null }
+output "otherId" { value = null }
+`,
+				".tflint.hcl": `
+rule "output_naming_convention" {
+  enabled       = true
+  allowed_names = ["legacyId"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewOutputNamingConventionRule(),
+					Message: `output name "otherId" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 17},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom format",
+			Content: map[string]string{
+				"outputs.tf": `
+output "out_id" { value = null }
+`,
+				".tflint.hcl": `
+rule "output_naming_convention" {
+  enabled = true
+  format  = "custom"
+  custom  = "^out_"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOutputNamingConventionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OutputOrderingRule checks whether outputs in outputs.tf are sorted
+type OutputOrderingRule struct {
+	tflint.DefaultRule
+}
+
+// NewOutputOrderingRule returns a new rule
+func NewOutputOrderingRule() *OutputOrderingRule {
+	return &OutputOrderingRule{}
+}
+
+// Name returns the rule name
+func (r *OutputOrderingRule) Name() string {
+	return "output_ordering"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OutputOrderingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OutputOrderingRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *OutputOrderingRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *OutputOrderingRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require outputs in outputs.tf to be sorted alphabetically",
+		Tags:        []string{TagStyle},
+		Example: `
+# outputs.tf
+output "name" { value = var.name }
+output "arn" { value = aws_s3_bucket.this.arn }
+`,
+	}
+}
+
+// Check emits an issue for the first output in outputs.tf that is out of alphabetical order
+func (r *OutputOrderingRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "output",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	order := newBlockOrder(body.Blocks, filenameOutputs, func(a, b *hclext.Block) bool {
+		return a.Labels[0] < b.Labels[0]
+	})
+	block, position := order.firstOutOfOrder()
+	if block == nil {
+		return nil
+	}
+
+	return runner.EmitIssueWithFix(
+		r,
+		fmt.Sprintf(`output %q is out of order, expected at position %d when sorted alphabetically`, block.Labels[0], position),
+		block.DefRange,
+		order.fix(runner),
+	)
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OutputOrderingRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "sorted",
+			Content: map[string]string{
+				"outputs.tf": `
+output "a" { value = 1 }
+output "b" { value = 2 }
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "other files are ignored",
+			Content: map[string]string{
+				"main.tf": `
+output "b" { value = 2 }
+output "a" { value = 1 }
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "out of order",
+			Content: map[string]string{
+				"outputs.tf": `
+output "b" { value = 2 }
+output "a" { value = 1 }
+output "c" { value = 3 }
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewOutputOrderingRule(),
+					Message: `output "b" is out of order, expected at position 2 when sorted alphabetically`,
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 11},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOutputOrderingRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// outputPreconditionRequiredRuleConfig is the config structure for the output_precondition_required rule
+type outputPreconditionRequiredRuleConfig struct {
+	Modules       []string `hclext:"modules,optional"`
+	ExemptOutputs []string `hclext:"exempt_outputs,optional"`
+}
+
+// OutputPreconditionRequiredRule checks whether outputs of contract modules validate their values
+type OutputPreconditionRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewOutputPreconditionRequiredRule returns a new rule
+func NewOutputPreconditionRequiredRule() *OutputPreconditionRequiredRule {
+	return &OutputPreconditionRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *OutputPreconditionRequiredRule) Name() string {
+	return "output_precondition_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OutputPreconditionRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *OutputPreconditionRequiredRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *OutputPreconditionRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *OutputPreconditionRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require outputs of contract modules to have a precondition block (Terraform 1.2+)",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "modules", Description: "Path globs of contract module directories, an empty list treats every module as a contract", Default: "[]"},
+			{Name: "exempt_outputs", Description: "Output names that need no precondition", Default: "[]"},
+		},
+		Example: `
+output "vpc_id" {
+  value = aws_vpc.main.id
+}
+`,
+	}
+}
+
+// Check emits issues for outputs without a precondition block in the modules matching the modules globs
+func (r *OutputPreconditionRequiredRule) Check(runner tflint.Runner) error {
+	config := &outputPreconditionRequiredRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	exemptOutputs := make(map[string]bool, len(config.ExemptOutputs))
+	for _, name := range config.ExemptOutputs {
+		exemptOutputs[name] = true
+	}
+	modules := make([]*regexp.Regexp, 0, len(config.Modules))
+	for _, glob := range config.Modules {
+		pattern, err := compileGlob(glob)
+		if err != nil {
+			return fmt.Errorf("invalid modules glob %q: %w", glob, err)
+		}
+		modules = append(modules, pattern)
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "output",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{{Type: "precondition", Body: &hclext.BodySchema{}}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, output := range body.Blocks {
+		name := output.Labels[0]
+		if exemptOutputs[name] || len(output.Body.Blocks) > 0 {
+			continue
+		}
+		dir := filepath.ToSlash(filepath.Dir(output.DefRange.Filename))
+		if len(modules) > 0 && !matchesAny(modules, dir) {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("output %q has no precondition block, validate the value this module guarantees to its callers", name),
+			output.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OutputPreconditionRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Filename string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name:     "precondition",
+			Filename: "outputs.tf",
+			Content: `
+output "vpc_id" {
+  value = aws_vpc.main.id
+
+  precondition {
+    condition     = aws_vpc.main.enable_dns_support
+    error_message = "The VPC must support DNS."
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name:     "no precondition",
+			Filename: "outputs.tf",
+			Content: `
+output "vpc_id" {
+  value = aws_vpc.main.id
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOutputPreconditionRequiredRule(),
+					Message: `output "vpc_id" has no precondition block, validate the value this module guarantees to its callers`,
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 16},
+					},
+				},
+			},
+		},
+		{
+			Name:     "not a contract module",
+			Filename: "live/prod/outputs.tf",
+			Content: `
+output "vpc_id" {
+  value = aws_vpc.main.id
+}
+`,
+			Config: `
+rule "output_precondition_required" {
+  enabled = true
+  modules = ["modules/**"]
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name:     "contract module",
+			Filename: "modules/network/outputs.tf",
+			Content: `
+output "vpc_id" {
+  value = aws_vpc.main.id
+}
+
+output "tags" {
+  value = var.tags
+}
+`,
+			Config: `
+rule "output_precondition_required" {
+  enabled        = true
+  modules        = ["modules/**"]
+  exempt_outputs = ["tags"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOutputPreconditionRequiredRule(),
+					Message: `output "vpc_id" has no precondition block, validate the value this module guarantees to its callers`,
+					Range: hcl.Range{
+						Filename: "modules/network/outputs.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 16},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOutputPreconditionRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{tc.Filename: tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
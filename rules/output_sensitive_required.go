@@ -0,0 +1,173 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OutputSensitiveRequiredRule checks whether outputs referencing sensitive values are marked sensitive
+type OutputSensitiveRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewOutputSensitiveRequiredRule returns a new rule
+func NewOutputSensitiveRequiredRule() *OutputSensitiveRequiredRule {
+	return &OutputSensitiveRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *OutputSensitiveRequiredRule) Name() string {
+	return "output_sensitive_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OutputSensitiveRequiredRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OutputSensitiveRequiredRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OutputSensitiveRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *OutputSensitiveRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require sensitive = true on outputs that reference sensitive values",
+		Tags:        []string{TagSecurity},
+		Example: `
+variable "db_password" {
+  type      = string
+  sensitive = true
+}
+
+output "connection_string" {
+  value = "postgres://admin:${var.db_password}@db"
+}
+`,
+	}
+}
+
+// Check emits issues for outputs whose value references a sensitive variable, directly or through locals,
+// without setting sensitive = true
+func (r *OutputSensitiveRequiredRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "sensitive"}},
+				},
+			},
+			{
+				Type: "locals",
+				Body: &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode},
+			},
+			{
+				Type:       "output",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "value"}, {Name: "sensitive"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	blocks := body.Blocks.ByType()
+
+	sensitive := map[string]bool{}
+	for _, variable := range blocks["variable"] {
+		attr, exists := variable.Body.Attributes["sensitive"]
+		if !exists {
+			continue
+		}
+		err := runner.EvaluateExpr(attr.Expr, func(v bool) error {
+			if v {
+				sensitive["var."+variable.Labels[0]] = true
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Propagate sensitivity through locals until nothing changes
+	locals := hclext.Attributes{}
+	for _, block := range blocks["locals"] {
+		for name, attr := range block.Body.Attributes {
+			locals[name] = attr
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for name, attr := range locals {
+			if sensitive["local."+name] {
+				continue
+			}
+			if sensitiveReference(attr, sensitive) != "" {
+				sensitive["local."+name] = true
+				changed = true
+			}
+		}
+	}
+
+	for _, output := range blocks["output"] {
+		value, exists := output.Body.Attributes["value"]
+		if !exists {
+			continue
+		}
+		ref := sensitiveReference(value, sensitive)
+		if ref == "" {
+			continue
+		}
+
+		marked := false
+		if attr, exists := output.Body.Attributes["sensitive"]; exists {
+			err := runner.EvaluateExpr(attr.Expr, func(v bool) error {
+				marked = v
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if marked {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("output %q references sensitive value %s and should set sensitive = true", output.Labels[0], ref),
+			output.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sensitiveReference returns the first sensitive var or local referenced by the attribute, or an empty string
+func sensitiveReference(attr *hclext.Attribute, sensitive map[string]bool) string {
+	for _, root := range []string{"var", "local"} {
+		for _, name := range referencedNames(attr.Expr, root) {
+			if ref := root + "." + name; sensitive[ref] {
+				return ref
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OutputSensitiveRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "sensitive output",
+			Content: map[string]string{
+				"main.tf": `
+variable "password" {
+  sensitive = true
+}
+output "password" {
+  value     = var.password
+  sensitive = true
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "non-sensitive variable",
+			Content: map[string]string{
+				"main.tf": `
+variable "region" {}
+output "region" {
+  value = var.region
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "direct reference",
+			Content: map[string]string{
+				"main.tf": `
+variable "password" {
+  sensitive = true
+}
+output "password" {
+  value = var.password
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewOutputSensitiveRequiredRule(),
+					Message: `output "password" references sensitive value var.password and should set sensitive = true`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 1},
+						End:      hcl.Pos{Line: 5, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "reference through locals",
+			Content: map[string]string{
+				"main.tf": `
+variable "password" {
+  sensitive = true
+}
+locals {
+  dsn        = "postgres://admin:${var.password}@db"
+  connection = { dsn = local.dsn }
+}
+output "connection" {
+  value = local.connection
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewOutputSensitiveRequiredRule(),
+					Message: `output "connection" references sensitive value local.connection and should set sensitive = true`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 9, Column: 1},
+						End:      hcl.Pos{Line: 9, Column: 20},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOutputSensitiveRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
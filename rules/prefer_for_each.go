@@ -0,0 +1,131 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// PreferForEachRule checks whether resources derive count from the length of a collection
+type PreferForEachRule struct {
+	tflint.DefaultRule
+}
+
+// NewPreferForEachRule returns a new rule
+func NewPreferForEachRule() *PreferForEachRule {
+	return &PreferForEachRule{}
+}
+
+// Name returns the rule name
+func (r *PreferForEachRule) Name() string {
+	return "prefer_for_each"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *PreferForEachRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *PreferForEachRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *PreferForEachRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *PreferForEachRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Prefer for_each over count derived from the length of a collection",
+		Tags:        []string{TagBestPractice},
+		Example: `
+resource "aws_iam_user" "this" {
+  count = length(var.users)
+  name  = var.users[count.index]
+}
+`,
+	}
+}
+
+// Check emits issues for resources whose count is computed with length(), suggesting for_each instead.
+// Conditional toggles such as `var.enabled ? 1 : 0` are exempt.
+func (r *PreferForEachRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "count"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range body.Blocks {
+		count, exists := resource.Body.Attributes["count"]
+		if !exists {
+			continue
+		}
+		expr, ok := count.Expr.(hclsyntax.Expression)
+		if !ok {
+			// JSON files are likely generated
+			continue
+		}
+		if isToggle(expr) || !callsFunction(expr, "length") {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf(
+				`resource "%s" "%s" derives count from the length of a collection, use for_each so reordering the collection does not recreate instances`,
+				resource.Labels[0],
+				resource.Labels[1],
+			),
+			count.Expr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isToggle returns whether the expression is a conditional choosing between two number literals
+func isToggle(expr hclsyntax.Expression) bool {
+	cond, ok := expr.(*hclsyntax.ConditionalExpr)
+	if !ok {
+		return false
+	}
+	for _, result := range []hclsyntax.Expression{cond.TrueResult, cond.FalseResult} {
+		lit, ok := result.(*hclsyntax.LiteralValueExpr)
+		if !ok || lit.Val.Type() != cty.Number {
+			return false
+		}
+	}
+	return true
+}
+
+// callsFunction returns whether the expression calls the named function anywhere
+func callsFunction(expr hclsyntax.Expression, name string) bool {
+	found := false
+	hclsyntax.VisitAll(expr, func(node hclsyntax.Node) hcl.Diagnostics {
+		if call, ok := node.(*hclsyntax.FunctionCallExpr); ok && call.Name == name {
+			found = true
+		}
+		return nil
+	})
+	return found
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_PreferForEachRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "for_each",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  for_each = toset(var.names)
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "toggle",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  count = length(var.names) > 0 ? 1 : 0
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "literal count",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  count = 3
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "length of collection",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  count = length(var.names)
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewPreferForEachRule(),
+					Message: `resource "aws_instance" "web" derives count from the length of a collection, use for_each so reordering the collection does not recreate instances`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "json",
+			Content: map[string]string{
+				"main.tf.json": `{"resource": {"aws_instance": {"web": {"count": "${length(var.names)}"}}}}`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewPreferForEachRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
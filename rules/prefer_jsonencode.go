@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// PreferJSONEncodeRule checks whether arguments are set to JSON written as a heredoc
+type PreferJSONEncodeRule struct {
+	tflint.DefaultRule
+}
+
+// NewPreferJSONEncodeRule returns a new rule
+func NewPreferJSONEncodeRule() *PreferJSONEncodeRule {
+	return &PreferJSONEncodeRule{}
+}
+
+// Name returns the rule name
+func (r *PreferJSONEncodeRule) Name() string {
+	return "prefer_jsonencode"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *PreferJSONEncodeRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *PreferJSONEncodeRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *PreferJSONEncodeRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *PreferJSONEncodeRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Prefer jsonencode() over JSON written in heredocs",
+		Tags:        []string{TagBestPractice},
+		Example: `
+resource "aws_iam_policy" "this" {
+  policy = <<-EOT
+    {"Version": "2012-10-17", "Statement": []}
+  EOT
+}
+`,
+	}
+}
+
+// Check emits issues for arguments whose value is a heredoc containing a JSON object or array.
+// Interpolations are replaced with a placeholder before parsing, so templated ARNs still count as JSON.
+func (r *PreferJSONEncodeRule) Check(runner tflint.Runner) error {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			// JSON files are likely generated
+			continue
+		}
+
+		attrs := []*hclsyntax.Attribute{}
+		hclsyntax.VisitAll(body, func(node hclsyntax.Node) hcl.Diagnostics {
+			if attr, ok := node.(*hclsyntax.Attribute); ok {
+				attrs = append(attrs, attr)
+			}
+			return nil
+		})
+		sort.Slice(attrs, func(i, j int) bool {
+			return attrs[i].SrcRange.Start.Byte < attrs[j].SrcRange.Start.Byte
+		})
+
+		for _, attr := range attrs {
+			heredoc, ok := asHeredoc(attr.Expr, files[name].Bytes)
+			if !ok || !isJSONTemplate(heredoc) {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%q is set to a JSON heredoc, use jsonencode() with an HCL object instead", attr.Name),
+				attr.SrcRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isJSONTemplate returns whether the template renders a JSON object or array, with every
+// interpolation replaced by a numeric placeholder
+func isJSONTemplate(template *hclsyntax.TemplateExpr) bool {
+	var rendered strings.Builder
+	for _, part := range template.Parts {
+		if lit, ok := part.(*hclsyntax.LiteralValueExpr); ok && lit.Val.Type() == cty.String {
+			rendered.WriteString(lit.Val.AsString())
+			continue
+		}
+		rendered.WriteString("0")
+	}
+
+	text := strings.TrimSpace(rendered.String())
+	if !strings.HasPrefix(text, "{") && !strings.HasPrefix(text, "[") {
+		return false
+	}
+	return json.Valid([]byte(text))
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_PreferJSONEncodeRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "jsonencode",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_iam_policy" "p" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+  })
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "non-JSON heredoc",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  user_data = <<-EOT
+    #!/bin/bash
+    echo {}
+  EOT
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "JSON heredoc",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_iam_policy" "p" {
+  policy = <<-EOT
+    {
+      "Version": "2012-10-17",
+      "Statement": [{
+        "Effect": "Allow",
+        "Resource": "arn:aws:s3:::${var.bucket}/*",
+        "Condition": ${jsonencode(var.condition)}
+      }]
+    }
+  EOT
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewPreferJSONEncodeRule(),
+					Message: `"policy" is set to a JSON heredoc, use jsonencode() with an HCL object instead`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 12, Column: 6},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewPreferJSONEncodeRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
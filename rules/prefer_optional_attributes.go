@@ -0,0 +1,156 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// preferOptionalAttributesRuleConfig is the config structure for the prefer_optional_attributes rule
+type preferOptionalAttributesRuleConfig struct {
+	CheckRequiredVersion *bool `hclext:"check_required_version,optional"`
+}
+
+// PreferOptionalAttributesRule checks whether object variables default attributes to null instead of using optional()
+type PreferOptionalAttributesRule struct {
+	tflint.DefaultRule
+}
+
+// NewPreferOptionalAttributesRule returns a new rule
+func NewPreferOptionalAttributesRule() *PreferOptionalAttributesRule {
+	return &PreferOptionalAttributesRule{}
+}
+
+// Name returns the rule name
+func (r *PreferOptionalAttributesRule) Name() string {
+	return "prefer_optional_attributes"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *PreferOptionalAttributesRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *PreferOptionalAttributesRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *PreferOptionalAttributesRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *PreferOptionalAttributesRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Prefer optional() object attributes over defaults that set attributes to null",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "check_required_version", Description: "Only report when required_version excludes Terraform before 1.3", Default: "true"},
+		},
+		Example: `
+variable "settings" {
+  type = object({
+    name = string
+    size = number
+  })
+  default = {
+    name = "app"
+    size = null
+  }
+}
+`,
+	}
+}
+
+// Check emits issues for object variables whose default sets attributes to null. Unless check_required_version
+// is false, modules whose required_version still allows Terraform older than 1.3 are skipped.
+func (r *PreferOptionalAttributesRule) Check(runner tflint.Runner) error {
+	config := &preferOptionalAttributesRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "type"}, {Name: "default"}},
+				},
+			},
+			{
+				Type: "terraform",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "required_version"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	blocks := body.Blocks.ByType()
+
+	if config.CheckRequiredVersion == nil || *config.CheckRequiredVersion {
+		supportsOlder, err := supportsTerraformBefore(runner, blocks["terraform"], "1.3")
+		if err != nil {
+			return err
+		}
+		if supportsOlder {
+			return nil
+		}
+	}
+
+	for _, variable := range blocks["variable"] {
+		typeAttr, exists := variable.Body.Attributes["type"]
+		if !exists {
+			continue
+		}
+		defaultAttr, exists := variable.Body.Attributes["default"]
+		if !exists {
+			continue
+		}
+
+		ty, _, diags := typeexpr.TypeConstraintWithDefaults(typeAttr.Expr)
+		if diags.HasErrors() || !ty.IsObjectType() {
+			continue
+		}
+		val, diags := defaultAttr.Expr.Value(nil)
+		if diags.HasErrors() || !val.IsWhollyKnown() || val.IsNull() || !val.Type().IsObjectType() {
+			continue
+		}
+
+		nulls := []string{}
+		for name := range ty.AttributeTypes() {
+			if ty.AttributeOptional(name) || !val.Type().HasAttribute(name) {
+				continue
+			}
+			if val.GetAttr(name).IsNull() {
+				nulls = append(nulls, name)
+			}
+		}
+		if len(nulls) == 0 {
+			continue
+		}
+		sort.Strings(nulls)
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("variable %q defaults %s to null, declare them with optional(type, default) in the type instead", variable.Labels[0], strings.Join(nulls, ", ")),
+			defaultAttr.Range,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
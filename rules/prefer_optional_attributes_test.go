@@ -0,0 +1,182 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_PreferOptionalAttributesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "optional attributes",
+			Content: map[string]string{
+				"variables.tf": `
+variable "settings" {
+  type = object({
+    name = string
+    size = optional(number)
+  })
+  default = {
+    name = "app"
+    size = null
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "null-defaulted attributes",
+			Content: map[string]string{
+				"variables.tf": `
+variable "settings" {
+  type = object({
+    name = string
+    size = number
+    zone = string
+  })
+  default = {
+    name = "app"
+    size = null
+    zone = null
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewPreferOptionalAttributesRule(),
+					Message: `variable "settings" defaults size, zone to null, declare them with optional(type, default) in the type instead`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 8, Column: 3},
+						End:      hcl.Pos{Line: 12, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "null default",
+			Content: map[string]string{
+				"variables.tf": `
+variable "settings" {
+  type = object({
+    name = string
+  })
+  default = null
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "not an object",
+			Content: map[string]string{
+				"variables.tf": `
+variable "settings" {
+  type    = map(string)
+  default = { name = null }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "older Terraform allowed",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_version = ">= 1.0"
+}
+`,
+				"variables.tf": `
+variable "settings" {
+  type    = object({ name = string })
+  default = { name = null }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Terraform 1.3 required",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_version = ">= 1.3"
+}
+`,
+				"variables.tf": `
+variable "settings" {
+  type    = object({ name = string })
+  default = { name = null }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewPreferOptionalAttributesRule(),
+					Message: `variable "settings" defaults name to null, declare them with optional(type, default) in the type instead`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "required version check disabled",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_version = ">= 1.0"
+}
+`,
+				"variables.tf": `
+variable "settings" {
+  type    = object({ name = string })
+  default = { name = null }
+}
+`,
+				".tflint.hcl": `
+rule "prefer_optional_attributes" {
+  enabled                = true
+  check_required_version = false
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewPreferOptionalAttributesRule(),
+					Message: `variable "settings" defaults name to null, declare them with optional(type, default) in the type instead`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 28},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewPreferOptionalAttributesRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package rules
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// preferTemplatefileRuleConfig is the config structure for the prefer_templatefile rule
+type preferTemplatefileRuleConfig struct {
+	MaxLines int `hclext:"max_lines,optional"`
+}
+
+// PreferTemplatefileRule checks whether resources embed large heredoc templates
+type PreferTemplatefileRule struct {
+	tflint.DefaultRule
+}
+
+// NewPreferTemplatefileRule returns a new rule
+func NewPreferTemplatefileRule() *PreferTemplatefileRule {
+	return &PreferTemplatefileRule{}
+}
+
+// Name returns the rule name
+func (r *PreferTemplatefileRule) Name() string {
+	return "prefer_templatefile"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *PreferTemplatefileRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *PreferTemplatefileRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *PreferTemplatefileRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *PreferTemplatefileRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Prefer templatefile() over large inline heredocs in resources",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "max_lines", Description: "Maximum number of lines of an inline heredoc", Default: "10"},
+		},
+		Example: `
+resource "aws_instance" "web" {
+  user_data = <<-EOT
+    #!/bin/bash
+    # more than 10 lines of script
+  EOT
+}
+`,
+	}
+}
+
+// Check emits issues for heredocs in resource arguments with more than max_lines lines of content
+func (r *PreferTemplatefileRule) Check(runner tflint.Runner) error {
+	config := &preferTemplatefileRuleConfig{MaxLines: 10}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			// JSON files are likely generated
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) != 2 {
+				continue
+			}
+
+			for _, heredoc := range heredocs(block.Body, files[name].Bytes) {
+				lines := heredocLines(heredoc)
+				if lines <= config.MaxLines {
+					continue
+				}
+
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf(
+						"heredoc in %s.%s is %d lines long, move it into a file under templates/ and render it with templatefile()",
+						block.Labels[0],
+						block.Labels[1],
+						lines,
+					),
+					heredoc.Range(),
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// heredocs returns the heredoc templates in a body and its nested blocks, in source order
+func heredocs(body *hclsyntax.Body, src []byte) []*hclsyntax.TemplateExpr {
+	found := []*hclsyntax.TemplateExpr{}
+	hclsyntax.VisitAll(body, func(node hclsyntax.Node) hcl.Diagnostics {
+		if template, ok := asHeredoc(node, src); ok {
+			found = append(found, template)
+		}
+		return nil
+	})
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].SrcRange.Start.Byte < found[j].SrcRange.Start.Byte
+	})
+	return found
+}
+
+// asHeredoc returns the node as a template if it is written in heredoc syntax
+func asHeredoc(node hclsyntax.Node, src []byte) (*hclsyntax.TemplateExpr, bool) {
+	template, ok := node.(*hclsyntax.TemplateExpr)
+	if !ok || !bytes.HasPrefix(template.SrcRange.SliceBytes(src), []byte("<<")) {
+		return nil, false
+	}
+	return template, true
+}
+
+// heredocLines returns the number of content lines in a heredoc, excluding the opening and closing markers
+func heredocLines(heredoc *hclsyntax.TemplateExpr) int {
+	return heredoc.SrcRange.End.Line - heredoc.SrcRange.Start.Line - 1
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_PreferTemplatefileRule(t *testing.T) {
+	config := `
+rule "prefer_templatefile" {
+  enabled   = true
+  max_lines = 2
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "short heredoc",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  user_data = <<-EOT
+    #!/bin/bash
+    echo hello
+  EOT
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "long heredoc outside resources",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  script = <<-EOT
+    #!/bin/bash
+    echo hello
+    echo world
+  EOT
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "long heredoc",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  user_data = "short"
+
+  provisioner "remote-exec" {
+    inline = [<<-EOT
+      #!/bin/bash
+      echo ${var.greeting}
+      echo world
+    EOT
+    ]
+  }
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewPreferTemplatefileRule(),
+					Message: "heredoc in aws_instance.web is 3 lines long, move it into a file under templates/ and render it with templatefile()",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 15},
+						End:      hcl.Pos{Line: 10, Column: 8},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewPreferTemplatefileRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// preferTerraformDataRuleConfig is the config structure for the prefer_terraform_data rule
+type preferTerraformDataRuleConfig struct {
+	CheckRequiredVersion *bool `hclext:"check_required_version,optional"`
+}
+
+// PreferTerraformDataRule checks whether null_resource is used where terraform_data is available
+type PreferTerraformDataRule struct {
+	tflint.DefaultRule
+}
+
+// NewPreferTerraformDataRule returns a new rule
+func NewPreferTerraformDataRule() *PreferTerraformDataRule {
+	return &PreferTerraformDataRule{}
+}
+
+// Name returns the rule name
+func (r *PreferTerraformDataRule) Name() string {
+	return "prefer_terraform_data"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *PreferTerraformDataRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *PreferTerraformDataRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *PreferTerraformDataRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *PreferTerraformDataRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Prefer terraform_data over null_resource on Terraform 1.4 and later",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "check_required_version", Description: "Only report when required_version excludes Terraform before 1.4", Default: "true"},
+		},
+		Example: `
+terraform {
+  required_version = ">= 1.4"
+}
+
+resource "null_resource" "setup" {}
+`,
+	}
+}
+
+// Check emits issues for null_resource blocks. Unless check_required_version is false, modules whose
+// required_version still allows Terraform older than 1.4 are skipped.
+func (r *PreferTerraformDataRule) Check(runner tflint.Runner) error {
+	config := &preferTerraformDataRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body:       &hclext.BodySchema{},
+			},
+			{
+				Type: "terraform",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "required_version"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	blocks := body.Blocks.ByType()
+
+	if config.CheckRequiredVersion == nil || *config.CheckRequiredVersion {
+		supportsOlder, err := supportsTerraformBefore(runner, blocks["terraform"], "1.4")
+		if err != nil {
+			return err
+		}
+		if supportsOlder {
+			return nil
+		}
+	}
+
+	for _, resource := range blocks["resource"] {
+		if resource.Labels[0] != "null_resource" {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf(`resource "null_resource" %q should be replaced by terraform_data, available since Terraform 1.4`, resource.Labels[1]),
+			resource.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_PreferTerraformDataRule(t *testing.T) {
+	issue := &helper.Issue{
+		Rule:    NewPreferTerraformDataRule(),
+		Message: `resource "null_resource" "bootstrap" should be replaced by terraform_data, available since Terraform 1.4`,
+		Range: hcl.Range{
+			Filename: "main.tf",
+			Start:    hcl.Pos{Line: 2, Column: 1},
+			End:      hcl.Pos{Line: 2, Column: 37},
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "terraform_data",
+			Content: map[string]string{
+				"main.tf": `
+resource "terraform_data" "bootstrap" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "no required_version",
+			Content: map[string]string{
+				"main.tf": `
+resource "null_resource" "bootstrap" {}
+`,
+			},
+			Expected: helper.Issues{issue},
+		},
+		{
+			Name: "requires 1.4 or later",
+			Content: map[string]string{
+				"main.tf": `
+resource "null_resource" "bootstrap" {}
+`,
+				"versions.tf": `
+terraform {
+  required_version = ">= 1.4.0"
+}
+`,
+			},
+			Expected: helper.Issues{issue},
+		},
+		{
+			Name: "supports older Terraform",
+			Content: map[string]string{
+				"main.tf": `
+resource "null_resource" "bootstrap" {}
+`,
+				"versions.tf": `
+terraform {
+  required_version = "~> 1.3.0"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "required_version check disabled",
+			Content: map[string]string{
+				"main.tf": `
+resource "null_resource" "bootstrap" {}
+`,
+				"versions.tf": `
+terraform {
+  required_version = ">= 1.0"
+}
+`,
+				".tflint.hcl": `
+rule "prefer_terraform_data" {
+  enabled                = true
+  check_required_version = false
+}
+`,
+			},
+			Expected: helper.Issues{issue},
+		},
+	}
+
+	rule := NewPreferTerraformDataRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Rule tags
+const (
+	// TagSecurity is for rules that prevent leaked secrets and destructive or unreviewed changes
+	TagSecurity = "security"
+	// TagCorrectness is for rules that catch likely bugs and unused or undeclared items
+	TagCorrectness = "correctness"
+	// TagStructure is for rules that enforce the file and module layout
+	TagStructure = "structure"
+	// TagDocumentation is for rules that require descriptions and module documents
+	TagDocumentation = "documentation"
+	// TagStyle is for rules that enforce naming, ordering and formatting
+	TagStyle = "style"
+	// TagBestPractice is for rules that prefer one language feature over another
+	TagBestPractice = "best_practice"
+)
+
+// Presets maps each preset name to the tags of the rules it enables
+var Presets = map[string][]string{
+	"minimal":     {TagSecurity, TagCorrectness},
+	"recommended": {TagSecurity, TagCorrectness, TagStructure, TagDocumentation},
+	"strict":      {TagSecurity, TagCorrectness, TagStructure, TagDocumentation, TagStyle, TagBestPractice},
+}
+
+// presetRules returns the names of the rules enabled by the preset
+func presetRules(preset string, rules []tflint.Rule) (map[string]bool, error) {
+	tags, exists := Presets[preset]
+	if !exists {
+		names := make([]string, 0, len(Presets))
+		for name := range Presets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("%q is an invalid preset. Valid presets are %s", preset, strings.Join(names, ", "))
+	}
+
+	included := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		included[tag] = true
+	}
+
+	enabled := map[string]bool{}
+	for _, rule := range rules {
+		meta := metadataOf(rule)
+		if meta == nil {
+			continue
+		}
+		for _, tag := range meta.Tags {
+			if included[tag] {
+				enabled[rule.Name()] = true
+			}
+		}
+	}
+	return enabled, nil
+}
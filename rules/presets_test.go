@@ -0,0 +1,34 @@
+package rules
+
+import "testing"
+
+func Test_Presets(t *testing.T) {
+	known := map[string]bool{}
+	for _, tag := range Presets["strict"] {
+		known[tag] = true
+	}
+
+	for _, rule := range Rules {
+		meta := metadataOf(rule)
+		if meta == nil || len(meta.Tags) == 0 {
+			t.Errorf("%s: rule has no tags", rule.Name())
+			continue
+		}
+		for _, tag := range meta.Tags {
+			if !known[tag] {
+				t.Errorf("%s: tag %q is not included in the strict preset", rule.Name(), tag)
+			}
+		}
+	}
+
+	for name, tags := range Presets {
+		if name == "strict" {
+			continue
+		}
+		for _, tag := range tags {
+			if !known[tag] {
+				t.Errorf("%s: tag %q is not included in the strict preset", name, tag)
+			}
+		}
+	}
+}
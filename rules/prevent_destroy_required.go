@@ -0,0 +1,142 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// preventDestroyRequiredRuleConfig is the config structure for the prevent_destroy_required rule
+type preventDestroyRequiredRuleConfig struct {
+	ResourceTypes []string `hclext:"resource_types,optional"`
+}
+
+// PreventDestroyRequiredRule checks whether critical resources are protected with prevent_destroy
+type PreventDestroyRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewPreventDestroyRequiredRule returns a new rule
+func NewPreventDestroyRequiredRule() *PreventDestroyRequiredRule {
+	return &PreventDestroyRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *PreventDestroyRequiredRule) Name() string {
+	return "prevent_destroy_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *PreventDestroyRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *PreventDestroyRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *PreventDestroyRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *PreventDestroyRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require lifecycle prevent_destroy on critical resource types",
+		Tags:        []string{TagSecurity},
+		Config: []ConfigOption{
+			{Name: "resource_types", Description: "Regular expressions of resource types that must set prevent_destroy", Default: "Databases on AWS, Google Cloud and Azure"},
+		},
+		Example: `
+resource "aws_db_instance" "db" {
+  engine = "postgres"
+}
+`,
+	}
+}
+
+// Check emits issues for resources matching resource_types that do not set lifecycle { prevent_destroy = true }
+func (r *PreventDestroyRequiredRule) Check(runner tflint.Runner) error {
+	config := &preventDestroyRequiredRuleConfig{
+		ResourceTypes: []string{
+			"^aws_db_instance$",
+			"^aws_rds_cluster$",
+			"^aws_dynamodb_table$",
+			"^google_sql_database_instance$",
+			"^azurerm_.*_database$",
+		},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	patterns := make([]*regexp.Regexp, 0, len(config.ResourceTypes))
+	for _, p := range config.ResourceTypes {
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return fmt.Errorf("invalid resource type pattern %q: %w", p, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "lifecycle",
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{{Name: "prevent_destroy"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range body.Blocks {
+		if !matchesAny(patterns, resource.Labels[0]) {
+			continue
+		}
+
+		protected := false
+		for _, lifecycle := range resource.Body.Blocks {
+			attr, exists := lifecycle.Body.Attributes["prevent_destroy"]
+			if !exists {
+				continue
+			}
+			err := runner.EvaluateExpr(attr.Expr, func(v bool) error {
+				protected = v
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if protected {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf(`resource "%s" "%s" should set lifecycle { prevent_destroy = true }`, resource.Labels[0], resource.Labels[1]),
+			resource.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_PreventDestroyRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "protected",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_db_instance" "db" {
+  lifecycle {
+    prevent_destroy = true
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "non-critical resource",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing and disabled prevent_destroy",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_db_instance" "db" {}
+resource "aws_dynamodb_table" "locks" {
+  lifecycle {
+    prevent_destroy = false
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewPreventDestroyRequiredRule(),
+					Message: `resource "aws_db_instance" "db" should set lifecycle { prevent_destroy = true }`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+				{
+					Rule:    NewPreventDestroyRequiredRule(),
+					Message: `resource "aws_dynamodb_table" "locks" should set lifecycle { prevent_destroy = true }`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom resource types",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_db_instance" "db" {}
+resource "aws_s3_bucket" "state" {}
+`,
+				".tflint.hcl": `
+rule "prevent_destroy_required" {
+  enabled        = true
+  resource_types = ["^aws_s3_bucket$"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewPreventDestroyRequiredRule(),
+					Message: `resource "aws_s3_bucket" "state" should set lifecycle { prevent_destroy = true }`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 33},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewPreventDestroyRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
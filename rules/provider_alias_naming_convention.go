@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/internal/naming"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// providerAliasNamingConventionRuleConfig is the config structure for the provider_alias_naming_convention rule
+type providerAliasNamingConventionRuleConfig struct {
+	Format string `hclext:"format,optional"`
+	Custom string `hclext:"custom,optional"`
+}
+
+// ProviderAliasNamingConventionRule checks whether provider aliases follow a naming convention
+type ProviderAliasNamingConventionRule struct {
+	tflint.DefaultRule
+}
+
+// NewProviderAliasNamingConventionRule returns a new rule
+func NewProviderAliasNamingConventionRule() *ProviderAliasNamingConventionRule {
+	return &ProviderAliasNamingConventionRule{}
+}
+
+// Name returns the rule name
+func (r *ProviderAliasNamingConventionRule) Name() string {
+	return "provider_alias_naming_convention"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ProviderAliasNamingConventionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *ProviderAliasNamingConventionRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *ProviderAliasNamingConventionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ProviderAliasNamingConventionRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Enforce a naming convention on provider aliases and disallow aliases named after the provider",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "format", Description: "snake_case, camelCase, PascalCase, kebab-case or custom", Default: "snake_case"},
+			{Name: "custom", Description: "Regular expression used when format is custom", Default: ""},
+		},
+		Example: `
+provider "aws" {
+  alias  = "aws"
+  region = "us-east-1"
+}
+`,
+	}
+}
+
+// Check emits issues for provider aliases that do not match the configured format or repeat the provider name
+func (r *ProviderAliasNamingConventionRule) Check(runner tflint.Runner) error {
+	format, custom := namingDefaults(runner)
+	config := &providerAliasNamingConventionRuleConfig{Format: format, Custom: custom}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	convention, err := naming.New(config.Format, config.Custom)
+	if err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "provider",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "alias"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, provider := range body.Blocks {
+		attr, exists := provider.Body.Attributes["alias"]
+		if !exists {
+			continue
+		}
+		alias, ok := literalString(attr.Expr)
+		if !ok {
+			continue
+		}
+
+		var message string
+		switch {
+		case alias == provider.Labels[0]:
+			message = fmt.Sprintf("provider alias %q repeats the provider name, name it after what sets it apart such as the region or account", alias)
+		case !convention.Match(alias):
+			message = fmt.Sprintf("provider alias %q must match the following %s", alias, convention)
+		default:
+			continue
+		}
+
+		if err := runner.EmitIssue(r, message, attr.Expr.Range()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ProviderAliasNamingConventionRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "valid aliases",
+			Content: map[string]string{
+				"providers.tf": `
+provider "aws" {
+  region = "us-east-1"
+}
+provider "aws" {
+  alias  = "us_west_2"
+  region = "us-west-2"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "invalid aliases",
+			Content: map[string]string{
+				"providers.tf": `
+provider "aws" {
+  alias = "UsWest2"
+}
+provider "google" {
+  alias = "google"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewProviderAliasNamingConventionRule(),
+					Message: `provider alias "UsWest2" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "providers.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 20},
+					},
+				},
+				{
+					Rule:    NewProviderAliasNamingConventionRule(),
+					Message: `provider alias "google" repeats the provider name, name it after what sets it apart such as the region or account`,
+					Range: hcl.Range{
+						Filename: "providers.tf",
+						Start:    hcl.Pos{Line: 6, Column: 11},
+						End:      hcl.Pos{Line: 6, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom format",
+			Content: map[string]string{
+				"providers.tf": `
+provider "aws" {
+  alias = "usWest2"
+}
+`,
+				".tflint.hcl": `
+rule "provider_alias_naming_convention" {
+  enabled = true
+  format  = "camelCase"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewProviderAliasNamingConventionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// providerDefaultTagsRequiredRuleConfig is the config structure for the provider_default_tags_required rule
+type providerDefaultTagsRequiredRuleConfig struct {
+	Providers []string `hclext:"providers,optional"`
+}
+
+// ProviderDefaultTagsRequiredRule checks whether provider configurations in root modules set default_tags
+type ProviderDefaultTagsRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewProviderDefaultTagsRequiredRule returns a new rule
+func NewProviderDefaultTagsRequiredRule() *ProviderDefaultTagsRequiredRule {
+	return &ProviderDefaultTagsRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *ProviderDefaultTagsRequiredRule) Name() string {
+	return "provider_default_tags_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ProviderDefaultTagsRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ProviderDefaultTagsRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *ProviderDefaultTagsRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ProviderDefaultTagsRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require provider configurations in root modules to set default_tags",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "providers", Description: "Providers that support default_tags", Default: `["aws"]`},
+		},
+		Example: `
+provider "aws" {
+  region = "us-east-1"
+}
+`,
+	}
+}
+
+// Check emits issues for configurations of the listed providers without a default_tags block in the root module
+func (r *ProviderDefaultTagsRequiredRule) Check(runner tflint.Runner) error {
+	config := &providerDefaultTagsRequiredRuleConfig{Providers: []string{"aws"}}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	tagged := make(map[string]bool, len(config.Providers))
+	for _, name := range config.Providers {
+		tagged[name] = true
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "provider",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "alias"}},
+					Blocks:     []hclext.BlockSchema{{Type: "default_tags", Body: &hclext.BodySchema{}}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, provider := range body.Blocks {
+		if !tagged[provider.Labels[0]] || len(provider.Body.Blocks) > 0 {
+			continue
+		}
+
+		name := fmt.Sprintf("%q", provider.Labels[0])
+		if alias, ok := provider.Body.Attributes["alias"]; ok {
+			if value, ok := literalString(alias.Expr); ok {
+				name = fmt.Sprintf("%q (alias %q)", provider.Labels[0], value)
+			}
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("provider %s has no default_tags block, set tags on the provider so every resource it manages is tagged", name),
+			provider.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ProviderDefaultTagsRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "default_tags set",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+
+  default_tags {
+    tags = {
+      team = "platform"
+    }
+  }
+}
+
+provider "google" {
+  project = "platform"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "default_tags missing",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "west"
+  region = "us-west-2"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewProviderDefaultTagsRequiredRule(),
+					Message: `provider "aws" has no default_tags block, set tags on the provider so every resource it manages is tagged`,
+					Range: hcl.Range{
+						Filename: "providers.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 15},
+					},
+				},
+				{
+					Rule:    NewProviderDefaultTagsRequiredRule(),
+					Message: `provider "aws" (alias "west") has no default_tags block, set tags on the provider so every resource it manages is tagged`,
+					Range: hcl.Range{
+						Filename: "providers.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 15},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom providers",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "awscc" {
+  region = "us-east-1"
+}
+`,
+			Config: `
+rule "provider_default_tags_required" {
+  enabled   = true
+  providers = ["awscc"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewProviderDefaultTagsRequiredRule(),
+					Message: `provider "awscc" has no default_tags block, set tags on the provider so every resource it manages is tagged`,
+					Range: hcl.Range{
+						Filename: "providers.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 17},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewProviderDefaultTagsRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"providers.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ProviderHardcodedCredentialsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no credentials",
+			Content: `
+provider "aws" {
+  region  = "eu-west-1"
+  profile = var.profile
+
+  assume_role {
+    role_arn = var.role_arn
+  }
+}
+
+provider "google" {
+  project = "platform"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "hardcoded credentials",
+			Content: `
+provider "aws" {
+  region     = "eu-west-1"
+  secret_key = "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"
+  profile    = "admin"
+
+  assume_role {
+    role_arn = var.role_arn
+  }
+}
+
+provider "google" {
+  credentials = "account.json"
+  project     = "platform"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewProviderHardcodedCredentialsRule(),
+					Message: `provider "aws" hardcodes secret_key, use environment variables or an authentication helper instead`,
+					Range: hcl.Range{
+						Filename: "providers.tf",
+						Start:    hcl.Pos{Line: 4, Column: 16},
+						End:      hcl.Pos{Line: 4, Column: 58},
+					},
+				},
+				{
+					Rule:    NewProviderHardcodedCredentialsRule(),
+					Message: `provider "aws" hardcodes profile, use environment variables or an authentication helper instead`,
+					Range: hcl.Range{
+						Filename: "providers.tf",
+						Start:    hcl.Pos{Line: 5, Column: 16},
+						End:      hcl.Pos{Line: 5, Column: 23},
+					},
+				},
+				{
+					Rule:    NewProviderHardcodedCredentialsRule(),
+					Message: `provider "google" hardcodes credentials, use environment variables or an authentication helper instead`,
+					Range: hcl.Range{
+						Filename: "providers.tf",
+						Start:    hcl.Pos{Line: 13, Column: 17},
+						End:      hcl.Pos{Line: 13, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom attributes",
+			Content: `
+provider "aws" {
+  profile = "admin"
+}
+
+provider "vault" {
+  token = "s.0123456789"
+}
+`,
+			Config: `
+rule "provider_hardcoded_credentials" {
+  enabled    = true
+  attributes = {
+    "*" = ["token"]
+    aws = ["access_key"]
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewProviderHardcodedCredentialsRule(),
+					Message: `provider "vault" hardcodes token, use environment variables or an authentication helper instead`,
+					Range: hcl.Range{
+						Filename: "providers.tf",
+						Start:    hcl.Pos{Line: 7, Column: 11},
+						End:      hcl.Pos{Line: 7, Column: 25},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewProviderHardcodedCredentialsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"providers.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
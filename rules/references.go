@@ -0,0 +1,34 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/internal/refs"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// referencedNames returns the names referenced under the given root in the expression,
+// e.g. "foo" for var.foo when root is "var"
+func referencedNames(expr hcl.Expression, root string) []string {
+	names := []string{}
+	for _, traversal := range expr.Variables() {
+		if traversal.RootName() != root || len(traversal) < 2 {
+			continue
+		}
+		if attr, ok := traversal[1].(hcl.TraverseAttr); ok {
+			names = append(names, attr.Name)
+		}
+	}
+	return names
+}
+
+// moduleReferences returns the reference graph of the module. Runners created by the ruleset build it once
+// and share it between rules, other runners build it on every call.
+func moduleReferences(runner tflint.Runner) (*refs.Graph, error) {
+	if r, ok := runner.(*globalRunner); ok {
+		runner = r.Runner
+	}
+	if r, ok := runner.(*cachingRunner); ok {
+		return r.References()
+	}
+	return refs.Build(runner)
+}
@@ -0,0 +1,243 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// defaultTaggedResourceTypes are common resource types that take a tags or labels map. Provider-wide patterns such as
+// ^aws_ would also match types that cannot be tagged, such as aws_iam_role_policy_attachment, and report all of them.
+var defaultTaggedResourceTypes = []string{
+	`^aws_(instance|launch_template|ebs_volume|vpc|subnet|security_group|internet_gateway|nat_gateway|eip|route_table|lb|alb|lb_target_group|s3_bucket|db_instance|rds_cluster|elasticache_cluster|dynamodb_table|lambda_function|ecs_cluster|ecs_service|eks_cluster|eks_node_group|kms_key|sns_topic|sqs_queue|cloudwatch_log_group|efs_file_system|iam_role|secretsmanager_secret)$`,
+	`^azurerm_(resource_group|virtual_network|network_security_group|public_ip|linux_virtual_machine|windows_virtual_machine|storage_account|key_vault|kubernetes_cluster|mssql_server|postgresql_flexible_server|service_plan|linux_web_app|windows_web_app|log_analytics_workspace)$`,
+	`^google_(storage_bucket|compute_disk|pubsub_topic|pubsub_subscription|bigquery_dataset|bigquery_table|cloudfunctions_function|redis_instance|kms_crypto_key)$`,
+}
+
+// requiredTagsRuleConfig is the config structure for the required_tags rule
+type requiredTagsRuleConfig struct {
+	Tags          []string `hclext:"tags,optional"`
+	ResourceTypes []string `hclext:"resource_types,optional"`
+}
+
+// RequiredTagsRule checks whether taggable resources set the required tag keys
+type RequiredTagsRule struct {
+	tflint.DefaultRule
+}
+
+// NewRequiredTagsRule returns a new rule
+func NewRequiredTagsRule() *RequiredTagsRule {
+	return &RequiredTagsRule{}
+}
+
+// Name returns the rule name
+func (r *RequiredTagsRule) Name() string {
+	return "required_tags"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *RequiredTagsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *RequiredTagsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *RequiredTagsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *RequiredTagsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require configured tag keys on taggable resources",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "tags", Description: "Tag keys every matching resource must set", Default: "[]"},
+			{Name: "resource_types", Description: "Regular expressions of resource types to check. The default covers common resources that take a tags or labels map", Default: `["` + strings.Join(defaultTaggedResourceTypes, `", "`) + `"]`},
+		},
+		Example: `
+resource "aws_s3_bucket" "logs" {
+  bucket = "logs"
+}
+`,
+	}
+}
+
+// Check emits issues for resources matching resource_types whose tags or labels are missing any of the required keys.
+// Tag expressions are evaluated, resolving merge() calls and variable defaults; values that cannot be
+// determined statically are skipped. With deep module analysis, resources in local modules called from this module
+// are checked with the arguments of the call and reported at the module block.
+func (r *RequiredTagsRule) Check(runner tflint.Runner) error {
+	config := &requiredTagsRuleConfig{
+		ResourceTypes: defaultTaggedResourceTypes,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if len(config.Tags) == 0 {
+		return nil
+	}
+
+	patterns := make([]*regexp.Regexp, 0, len(config.ResourceTypes))
+	for _, p := range config.ResourceTypes {
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return fmt.Errorf("invalid resource type pattern %q: %w", p, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+
+	schema := &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "tags"}, {Name: "labels"}},
+				},
+			},
+		},
+	}
+	body, err := runner.GetModuleContent(schema, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range body.Blocks {
+		if !matchesAny(patterns, resource.Labels[0]) {
+			continue
+		}
+
+		missing, rng, err := r.missingTags(resource, config.Tags, runnerEvaluator(runner))
+		if err != nil {
+			return err
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf(`resource "%s" "%s" is missing required tags: %s`, resource.Labels[0], resource.Labels[1], strings.Join(missing, ", ")),
+			rng,
+		); err != nil {
+			return err
+		}
+	}
+
+	// With deep module analysis, resources of called modules are reported at the module call
+	modules, err := calledModules(runner)
+	if err != nil {
+		return err
+	}
+	for _, module := range modules {
+		content, err := module.content(schema)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range content.Blocks {
+			if !matchesAny(patterns, resource.Labels[0]) {
+				continue
+			}
+
+			missing, _, err := r.missingTags(resource, config.Tags, module.evaluate)
+			if err != nil {
+				return err
+			}
+			if len(missing) == 0 {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf(`resource "%s" "%s" in %s is missing required tags: %s`, resource.Labels[0], resource.Labels[1], module.addr, strings.Join(missing, ", ")),
+				module.callRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// missingTags returns the required keys missing from the tags or labels of the resource, along with the range to report.
+// It returns nothing when the keys cannot be determined statically.
+func (r *RequiredTagsRule) missingTags(resource *hclext.Block, tags []string, evaluate evaluator) ([]string, hcl.Range, error) {
+	attr, exists := resource.Body.Attributes["tags"]
+	if !exists {
+		attr, exists = resource.Body.Attributes["labels"]
+	}
+	if !exists {
+		return tags, resource.DefRange, nil
+	}
+
+	values, known, err := tagValues(evaluate, attr.Expr)
+	if err != nil || !known {
+		return nil, hcl.Range{}, err
+	}
+
+	missing := []string{}
+	for _, key := range tags {
+		if _, exists := values[key]; !exists {
+			missing = append(missing, key)
+		}
+	}
+	return missing, attr.Expr.Range(), nil
+}
+
+// matchesAny returns whether the value matches any of the patterns
+func matchesAny(patterns []*regexp.Regexp, value string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// tagValues returns the values of a tags expression by key, and whether the keys could be determined statically.
+// Values that are not known are returned as unknown values. merge() calls are resolved argument by argument,
+// so they only need the keys of each argument to be known.
+func tagValues(evaluate evaluator, expr hcl.Expression) (map[string]cty.Value, bool, error) {
+	if call, ok := expr.(*hclsyntax.FunctionCallExpr); ok && call.Name == "merge" {
+		values := map[string]cty.Value{}
+		for _, arg := range call.Args {
+			argValues, known, err := tagValues(evaluate, arg)
+			if err != nil || !known {
+				return nil, known, err
+			}
+			for key, value := range argValues {
+				values[key] = value
+			}
+		}
+		return values, true, nil
+	}
+
+	value, err := evaluate(expr)
+	if err != nil {
+		return nil, false, err
+	}
+	if !value.IsKnown() || value.IsNull() {
+		return nil, false, nil
+	}
+	if !value.Type().IsObjectType() && !value.Type().IsMapType() {
+		return nil, false, nil
+	}
+	values := map[string]cty.Value{}
+	for it := value.ElementIterator(); it.Next(); {
+		key, val := it.Element()
+		values[key.AsString()] = val
+	}
+	return values, true, nil
+}
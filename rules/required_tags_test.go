@@ -0,0 +1,217 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_RequiredTagsRule(t *testing.T) {
+	config := `
+rule "required_tags" {
+  enabled = true
+  tags    = ["Owner", "CostCenter"]
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no required tags configured",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "all tags set",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  tags = {
+    Owner      = "platform"
+    CostCenter = "1234"
+  }
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "merged tags",
+			Content: map[string]string{
+				"main.tf": `
+variable "common_tags" {
+  default = {
+    CostCenter = "1234"
+  }
+}
+resource "aws_instance" "web" {
+  tags = merge(var.common_tags, { Owner = "platform" })
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unknown tags",
+			Content: map[string]string{
+				"main.tf": `
+variable "tags" {}
+resource "aws_instance" "web" {
+  tags = var.tags
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing keys",
+			Content: map[string]string{
+				"main.tf": `
+resource "google_storage_bucket" "b" {
+  labels = {
+    Owner = "platform"
+  }
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewRequiredTagsRule(),
+					Message: `resource "google_storage_bucket" "b" is missing required tags: CostCenter`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 12},
+						End:      hcl.Pos{Line: 5, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing tags attribute",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {}
+resource "random_id" "id" {}
+resource "aws_iam_role_policy_attachment" "admin" {}
+resource "google_project_iam_member" "viewer" {}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewRequiredTagsRule(),
+					Message: `resource "aws_instance" "web" is missing required tags: Owner, CostCenter`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewRequiredTagsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_RequiredTagsRule_DeepModuleAnalysis(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Deep     bool
+		Expected func(filename string) helper.Issues
+	}{
+		{
+			Name:     "called modules are not followed by default",
+			Expected: func(filename string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "resources of called modules are checked with the call arguments",
+			Deep: true,
+			Expected: func(filename string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewRequiredTagsRule(),
+						Message: `resource "aws_instance" "web" in module.app is missing required tags: CostCenter`,
+						Range: hcl.Range{
+							Filename: filename,
+							Start:    hcl.Pos{Line: 2, Column: 1},
+							End:      hcl.Pos{Line: 2, Column: 13},
+						},
+					},
+				}
+			},
+		},
+	}
+
+	rule := NewRequiredTagsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, map[string]string{
+				"modules/app/main.tf": `
+variable "tags" {
+  default = {}
+}
+resource "aws_instance" "web" {
+  tags = var.tags
+}
+`,
+				// Called modules that cannot be parsed are skipped
+				"modules/broken/main.tf": `resource "aws_instance" {`,
+			})
+
+			filename := filepath.Join(dir, "main.tf")
+			runner := helper.TestRunner(t, map[string]string{
+				filename: `
+module "app" {
+  source = "./modules/app"
+  tags   = { Owner = "platform" }
+}
+
+module "broken" {
+  source = "./modules/broken"
+}
+`,
+				".tflint.hcl": `
+rule "required_tags" {
+  enabled = true
+  tags    = ["Owner", "CostCenter"]
+}
+`,
+			})
+
+			global := &globalRunner{Runner: runner, config: &GlobalConfig{DeepModuleAnalysis: tc.Deep}}
+			if err := rule.Check(global); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(filename), runner.Issues)
+		})
+	}
+}
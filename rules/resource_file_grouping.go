@@ -0,0 +1,137 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// resourceFileGroupingRuleConfig is the config structure for the resource_file_grouping rule
+type resourceFileGroupingRuleConfig struct {
+	Groups map[string]string `hclext:"groups,optional"`
+}
+
+// resourceGroup is a resource type glob and the file resources matching it belong in
+type resourceGroup struct {
+	glob     string
+	pattern  *regexp.Regexp
+	filename string
+}
+
+// ResourceFileGroupingRule checks whether resources are declared in the file of their type group
+type ResourceFileGroupingRule struct {
+	tflint.DefaultRule
+}
+
+// NewResourceFileGroupingRule returns a new rule
+func NewResourceFileGroupingRule() *ResourceFileGroupingRule {
+	return &ResourceFileGroupingRule{}
+}
+
+// Name returns the rule name
+func (r *ResourceFileGroupingRule) Name() string {
+	return "resource_file_grouping"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ResourceFileGroupingRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ResourceFileGroupingRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *ResourceFileGroupingRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ResourceFileGroupingRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require resources to be declared in the file configured for their resource type",
+		Tags:        []string{TagStructure},
+		Config: []ConfigOption{
+			{Name: "groups", Description: "Map of resource type globs, such as aws_iam_*, to the file their resources belong in. The longest matching glob wins", Default: "{}"},
+		},
+		Example: `
+# main.tf, with groups = { "aws_iam_*" = "iam.tf" }
+resource "aws_iam_role" "app" {
+  name = "app"
+}
+`,
+	}
+}
+
+// Check emits issues for resources whose type matches a group but that are declared in another file.
+// Resources whose type matches no group may be declared anywhere.
+func (r *ResourceFileGroupingRule) Check(runner tflint.Runner) error {
+	config := &resourceFileGroupingRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if len(config.Groups) == 0 {
+		return nil
+	}
+
+	groups := make([]resourceGroup, 0, len(config.Groups))
+	for glob, filename := range config.Groups {
+		pattern, err := compileGlob(glob)
+		if err != nil {
+			return fmt.Errorf("invalid resource type glob %q: %w", glob, err)
+		}
+		groups = append(groups, resourceGroup{glob: glob, pattern: pattern, filename: filename})
+	}
+	// Prefer the most specific glob, so aws_iam_policy_* can be grouped apart from aws_iam_*
+	sort.Slice(groups, func(i, j int) bool {
+		if len(groups[i].glob) != len(groups[j].glob) {
+			return len(groups[i].glob) > len(groups[j].glob)
+		}
+		return groups[i].glob < groups[j].glob
+	})
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: &hclext.BodySchema{}},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range body.Blocks {
+		var group *resourceGroup
+		for i := range groups {
+			if groups[i].pattern.MatchString(resource.Labels[0]) {
+				group = &groups[i]
+				break
+			}
+		}
+		if group == nil {
+			continue
+		}
+
+		filename := filepath.Base(resource.DefRange.Filename)
+		if filename == group.filename {
+			continue
+		}
+
+		if err := runner.EmitIssueWithFix(
+			r,
+			fmt.Sprintf("%s.%s should be moved from %s to %s, where %s resources are grouped", resource.Labels[0], resource.Labels[1], filename, group.filename, group.glob),
+			resource.DefRange,
+			moveBlockFix(runner, resource, group.filename),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
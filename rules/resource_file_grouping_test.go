@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ResourceFileGroupingRule(t *testing.T) {
+	config := `
+rule "resource_file_grouping" {
+  enabled = true
+  groups = {
+    "aws_iam_*"        = "iam.tf"
+    "aws_iam_policy*"  = "policies.tf"
+    "aws_s3_*"         = "storage.tf"
+  }
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Fixed    map[string]string
+	}{
+		{
+			Name: "no groups",
+			Content: map[string]string{
+				"main.tf": `resource "aws_iam_role" "app" {}`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "grouped",
+			Content: map[string]string{
+				"main.tf":     `resource "aws_instance" "app" {}`,
+				"iam.tf":      `resource "aws_iam_role" "app" {}`,
+				"policies.tf": `resource "aws_iam_policy" "app" {}`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "misplaced",
+			Content: map[string]string{
+				"main.tf": `resource "aws_iam_role" "app" {}
+
+resource "aws_iam_policy" "app" {}
+`,
+				"iam.tf":      "",
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewResourceFileGroupingRule(),
+					Message: "aws_iam_role.app should be moved from main.tf to iam.tf, where aws_iam_* resources are grouped",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 1, Column: 1},
+						End:      hcl.Pos{Line: 1, Column: 30},
+					},
+				},
+				{
+					Rule:    NewResourceFileGroupingRule(),
+					Message: "aws_iam_policy.app should be moved from main.tf to policies.tf, where aws_iam_policy* resources are grouped",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 32},
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"main.tf": `
+resource "aws_iam_policy" "app" {}
+`,
+				"iam.tf": `resource "aws_iam_role" "app" {}
+`,
+			},
+		},
+	}
+
+	rule := NewResourceFileGroupingRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			if tc.Fixed != nil {
+				helper.AssertChanges(t, tc.Fixed, runner.Changes())
+			}
+		})
+	}
+}
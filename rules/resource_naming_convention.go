@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/internal/naming"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// resourceNamingConventionRuleConfig is the config structure for the resource_naming_convention rule
+type resourceNamingConventionRuleConfig struct {
+	Format               string `hclext:"format,optional"`
+	Custom               string `hclext:"custom,optional"`
+	ForbidTypeRepetition bool   `hclext:"forbid_type_repetition,optional"`
+}
+
+// ResourceNamingConventionRule checks whether resource and data source names follow a naming convention
+type ResourceNamingConventionRule struct {
+	tflint.DefaultRule
+}
+
+// NewResourceNamingConventionRule returns a new rule
+func NewResourceNamingConventionRule() *ResourceNamingConventionRule {
+	return &ResourceNamingConventionRule{}
+}
+
+// Name returns the rule name
+func (r *ResourceNamingConventionRule) Name() string {
+	return "resource_naming_convention"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ResourceNamingConventionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *ResourceNamingConventionRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *ResourceNamingConventionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ResourceNamingConventionRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Enforce a naming convention on resource and data source names",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "format", Description: "snake_case, camelCase, PascalCase, kebab-case or custom", Default: "snake_case"},
+			{Name: "custom", Description: "Regular expression used when format is custom", Default: ""},
+			{Name: "forbid_type_repetition", Description: "Report names that repeat the resource type", Default: "false"},
+		},
+		Example: `
+resource "aws_s3_bucket" "LogsBucket" {
+  bucket = "logs"
+}
+`,
+	}
+}
+
+// Check emits issues for resource and data source names that do not match the configured format
+// or that repeat the resource type when forbid_type_repetition is enabled
+func (r *ResourceNamingConventionRule) Check(runner tflint.Runner) error {
+	format, custom := namingDefaults(runner)
+	config := &resourceNamingConventionRuleConfig{Format: format, Custom: custom}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	convention, err := naming.New(config.Format, config.Custom)
+	if err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body:       &hclext.BodySchema{},
+			},
+			{
+				Type:       "data",
+				LabelNames: []string{"type", "name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, block := range body.Blocks {
+		resourceType, name := block.Labels[0], block.Labels[1]
+
+		if !convention.Match(name) {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s name %q must match the following %s", block.Type, name, convention),
+				block.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if config.ForbidTypeRepetition && repeatsResourceType(resourceType, name) {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s name %q should not repeat the resource type %q", block.Type, name, resourceType),
+				block.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// repeatsResourceType returns whether the name contains the resource type without its provider prefix,
+// e.g. "s3_bucket_logs" for "aws_s3_bucket"
+func repeatsResourceType(resourceType string, name string) bool {
+	_, suffix, found := strings.Cut(resourceType, "_")
+	if !found {
+		suffix = resourceType
+	}
+	return strings.Contains(name, suffix)
+}
@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ResourceNamingConventionRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "snake_case by default",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_s3_bucket" "logs" {}
+data "aws_s3_bucket" "s3_bucket_bucket" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "invalid resource and data names",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_s3_bucket" "Logs" {}
+data "aws_ami" "my-ami" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewResourceNamingConventionRule(),
+					Message: `resource name "Logs" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+				{
+					Rule:    NewResourceNamingConventionRule(),
+					Message: `data name "my-ami" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "forbid type repetition",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_s3_bucket" "s3_bucket_bucket" {}
+resource "aws_s3_bucket" "logs" {}
+`,
+				".tflint.hcl": `
+rule "resource_naming_convention" {
+  enabled                = true
+  forbid_type_repetition = true
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewResourceNamingConventionRule(),
+					Message: `resource name "s3_bucket_bucket" should not repeat the resource type "aws_s3_bucket"`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 44},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewResourceNamingConventionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package rules
+
+import "github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+// Rules is the list of all rules in the ruleset
+var Rules = []tflint.Rule{
+	NewStandardModuleStructureRule(),
+	NewForceDestroyRequiresCommentRule(),
+	NewTerraformVersionsFileRule(),
+	NewTerraformProvidersFileRule(),
+	NewVariableDescriptionRequiredRule(),
+	NewVariableTypeRequiredRule(),
+	NewOutputDescriptionRequiredRule(),
+	NewVariableNamingConventionRule(),
+	NewOutputNamingConventionRule(),
+	NewResourceNamingConventionRule(),
+	NewModuleNamingConventionRule(),
+	NewTerraformLocalsFileRule(),
+	NewTerraformDataFileRule(),
+	NewModuleExamplesRequiredRule(),
+	NewNestedModuleStructureRule(),
+	NewModuleLicenseRequiredRule(),
+	NewModuleChangelogRequiredRule(),
+	NewTerraformRequiredProvidersVersionRule(),
+	NewModulePinnedSourceRule(),
+	NewNoProviderInReusableModuleRule(),
+	NewNoHardcodedSecretsRule(),
+	NewTerraformRemoteBackendRequiredRule(),
+	NewTerraformRequiredVersionRule(),
+	NewInterpolationOnlyExpressionRule(),
+	NewVariableSensitiveRequiredRule(),
+	NewOutputSensitiveRequiredRule(),
+	NewPreferForEachRule(),
+	NewFileComplexityRule(),
+	NewVariableOrderingRule(),
+	NewOutputOrderingRule(),
+	NewMetaArgumentOrderingRule(),
+	NewRequiredTagsRule(),
+	NewModuleReadmeDocumentedRule(),
+	NewTerraformWorkspaceReferenceRule(),
+	NewNoExecProvisionerRule(),
+	NewPreferTerraformDataRule(),
+	NewPreventDestroyRequiredRule(),
+	NewVariableValidationRequiredRule(),
+	NewTerraformRefactoringFilesRule(),
+	NewUnusedVariableRule(),
+	NewUnusedLocalRule(),
+	NewUnusedDataSourceRule(),
+	NewTerraformRequiredProvidersCoverageRule(),
+	NewModuleSourceFirstRule(),
+	NewUnnecessaryDependsOnRule(),
+	NewDisallowedResourceTypesRule(),
+	NewModuleNestingDepthRule(),
+	NewFormattingRule(),
+	NewPreferTemplatefileRule(),
+	NewPreferJSONEncodeRule(),
+	NewTerraformAllowedBackendsRule(),
+	NewTagValueFormatRule(),
+	NewVariableTypeNoAnyRule(),
+	NewPreferOptionalAttributesRule(),
+	NewNoLiteralOutputRule(),
+	NewNoPassthroughOutputRule(),
+	NewNoModuleTfvarsRule(),
+	NewFileNamingConventionRule(),
+	NewModuleReadmeSectionsRule(),
+	NewVersionsFileTerraformOnlyRule(),
+	NewTooManyVariablesRule(),
+	NewTooManyOutputsRule(),
+	NewModuleComplexityRule(),
+	NewDynamicBlockNestingRule(),
+	NewNestedConditionalRule(),
+	NewLineLengthRule(),
+	NewCommentStyleRule(),
+	NewTodoCommentRule(),
+	NewFileHeaderRule(),
+	NewProviderAliasNamingConventionRule(),
+	NewModuleExplicitProvidersRule(),
+	NewModuleSourceAllowlistRule(),
+	NewLocalModuleSourceRule(),
+	NewForEachStableKeysRule(),
+	NewToggleVariableNamingRule(),
+	NewBoolVariablePrefixRule(),
+	NewVariableReservedNameRule(),
+	NewDescriptionStyleRule(),
+	NewTerraformRequiredVersionConstraintRule(),
+	NewTerraformRequiredProvidersConstraintRule(),
+	NewTerraformSingleBlockRule(),
+	NewNoPerpetualDiffFunctionsRule(),
+	NewDeprecatedFunctionsRule(),
+	NewNoTerraformRemoteStateRule(),
+	NewVariableValidationMessageRule(),
+	NewNoHardcodedNetworkAddressesRule(),
+	NewNoHardcodedCloudIdentifiersRule(),
+	NewNoSecretVariableDefaultsRule(),
+	NewModuleTestsRequiredRule(),
+	NewTestRunAssertionsRule(),
+	NewModuleReadmeDocsDriftRule(),
+	NewVariablesOutputsFilesOnlyRule(),
+	NewVariableDefaultTypeRule(),
+	NewEnumVariableValidationRule(),
+	NewModuleCallsPerFileRule(),
+	NewResourceFileGroupingRule(),
+	NewEmptyFileRule(),
+	NewLocalNamingConventionRule(),
+	NewEnvironmentConditionalsRule(),
+	NewProviderDefaultTagsRequiredRule(),
+	NewLifecycleIgnoreChangesRule(),
+	NewModuleReadmeTitleRule(),
+	NewVariableUsedOnlyInOutputsRule(),
+	NewMetaArgumentUnknownValueRule(),
+	NewOutputPreconditionRequiredRule(),
+	NewDeprecatedVariableRule(),
+	NewDuplicateTagKeysRule(),
+	NewStaticDynamicBlockRule(),
+	NewNoEnvironmentInNamesRule(),
+	NewBlankLinesRule(),
+	NewHardcodedModuleArgumentRule(),
+	NewImportTargetExistsRule(),
+	NewMovedBlockChainRule(),
+	NewProviderHardcodedCredentialsRule(),
+	NewModuleLayoutRule(),
+}
@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_Rules(t *testing.T) {
+	registered := map[string]bool{}
+	names := map[string]bool{}
+	for _, rule := range Rules {
+		registered[reflect.TypeOf(rule).Elem().Name()] = true
+		if names[rule.Name()] {
+			t.Errorf("%s: rule is registered more than once", rule.Name())
+		}
+		names[rule.Name()] = true
+	}
+
+	filenames, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	fset := token.NewFileSet()
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filename, nil, 0)
+		if err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				name := spec.(*ast.TypeSpec).Name.Name
+				if strings.HasSuffix(name, "Rule") && ast.IsExported(name) && !registered[name] {
+					t.Errorf("%s is declared in %s but not registered in Rules", name, filename)
+				}
+			}
+		}
+	}
+}
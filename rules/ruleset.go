@@ -0,0 +1,335 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/internal/naming"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// GlobalConfig is the ruleset-wide config declared in the plugin block of .tflint.hcl
+type GlobalConfig struct {
+	Preset            string            `hclext:"preset,optional"`
+	NamingFormat      string            `hclext:"naming_format,optional"`
+	NamingCustom      string            `hclext:"naming_custom,optional"`
+	IgnorePaths       []string          `hclext:"ignore_paths,optional"`
+	SeverityOverrides map[string]string `hclext:"severity_overrides,optional"`
+
+	DeepModuleAnalysis bool `hclext:"deep_module_analysis,optional"`
+}
+
+// RuleSet is the ruleset that applies the global config to every rule
+type RuleSet struct {
+	tflint.BuiltinRuleSet
+
+	globalConfig *tflint.Config
+	config       *GlobalConfig
+	ignorePaths  []*regexp.Regexp
+	severities   map[string]tflint.Severity
+}
+
+// ApplyGlobalConfig applies the common config and keeps it so that ApplyConfig can apply a preset
+func (r *RuleSet) ApplyGlobalConfig(config *tflint.Config) error {
+	r.globalConfig = config
+	return r.BuiltinRuleSet.ApplyGlobalConfig(config)
+}
+
+// ConfigSchema returns the schema of the plugin block
+func (r *RuleSet) ConfigSchema() *hclext.BodySchema {
+	return hclext.ImpliedBodySchema(&GlobalConfig{})
+}
+
+// ApplyConfig decodes and validates the plugin block
+func (r *RuleSet) ApplyConfig(content *hclext.BodyContent) error {
+	config := &GlobalConfig{}
+	if diags := hclext.DecodeBody(content, nil, config); diags.HasErrors() {
+		return diags
+	}
+
+	if config.NamingFormat != "" {
+		if _, err := naming.New(config.NamingFormat, config.NamingCustom); err != nil {
+			return fmt.Errorf("invalid naming_format: %w", err)
+		}
+	}
+
+	ignorePaths := make([]*regexp.Regexp, 0, len(config.IgnorePaths))
+	for _, glob := range config.IgnorePaths {
+		pattern, err := compileGlob(glob)
+		if err != nil {
+			return fmt.Errorf("invalid ignore_paths glob %q: %w", glob, err)
+		}
+		ignorePaths = append(ignorePaths, pattern)
+	}
+
+	severities := make(map[string]tflint.Severity, len(config.SeverityOverrides))
+	for name, value := range config.SeverityOverrides {
+		if r.Rule(name) == nil {
+			return fmt.Errorf("severity_overrides: rule %q is not defined in this ruleset", name)
+		}
+		severity, err := toSeverity(value)
+		if err != nil {
+			return fmt.Errorf("severity_overrides: %w", err)
+		}
+		severities[name] = severity
+	}
+
+	if config.Preset != "" {
+		if err := r.applyPreset(config.Preset); err != nil {
+			return err
+		}
+	}
+
+	r.config = config
+	r.ignorePaths = ignorePaths
+	r.severities = severities
+	return nil
+}
+
+// applyPreset enables the rules of the preset in place of their defaults.
+// The --only option and rule blocks still take precedence over the preset.
+func (r *RuleSet) applyPreset(preset string) error {
+	enabledByPreset, err := presetRules(preset, r.Rules)
+	if err != nil {
+		return err
+	}
+
+	global := r.globalConfig
+	if global == nil {
+		global = &tflint.Config{}
+	}
+	only := make(map[string]bool, len(global.Only))
+	for _, name := range global.Only {
+		only[name] = true
+	}
+
+	r.EnabledRules = []tflint.Rule{}
+	for _, rule := range r.Rules {
+		enabled := enabledByPreset[rule.Name()]
+		if len(only) > 0 {
+			enabled = only[rule.Name()]
+		} else if cfg := global.Rules[rule.Name()]; cfg != nil {
+			enabled = cfg.Enabled
+		}
+
+		if enabled {
+			r.EnabledRules = append(r.EnabledRules, rule)
+		}
+	}
+	return nil
+}
+
+// Rule returns the rule with the given name, or nil if it is not defined
+func (r *RuleSet) Rule(name string) tflint.Rule {
+	for _, rule := range r.Rules {
+		if rule.Name() == name {
+			return rule
+		}
+	}
+	return nil
+}
+
+// NewRunner wraps the runner so that rules see the global config and share cached requests
+func (r *RuleSet) NewRunner(runner tflint.Runner) (tflint.Runner, error) {
+	config := r.config
+	if config == nil {
+		config = &GlobalConfig{}
+	}
+	return &globalRunner{
+		Runner:      newCachingRunner(runner),
+		config:      config,
+		ignorePaths: r.ignorePaths,
+		severities:  r.severities,
+	}, nil
+}
+
+// globalRunner is a runner that drops issues in ignored paths and applies severity overrides
+type globalRunner struct {
+	tflint.Runner
+
+	config      *GlobalConfig
+	ignorePaths []*regexp.Regexp
+	// ruleIgnorePaths are the ignore_paths of each rule block, keyed by rule name once the rule config is decoded
+	ruleIgnorePaths map[string][]*regexp.Regexp
+	severities      map[string]tflint.Severity
+	// ruleSeverities are the severities set in rule blocks, which take precedence over severity_overrides
+	ruleSeverities map[string]tflint.Severity
+}
+
+// DecodeRuleConfig decodes the rule block into ret, accepting the ignore_paths and severity attributes every
+// rule block supports on top of the options of the rule. The rule block is decoded against the schema of ret,
+// so ret is extended with ignore_paths and severity fields for decoding and the rest of the fields are copied back.
+func (r *globalRunner) DecodeRuleConfig(name string, ret interface{}) error {
+	target := reflect.ValueOf(ret)
+	if target.Kind() != reflect.Ptr || target.Elem().Kind() != reflect.Struct {
+		return r.Runner.DecodeRuleConfig(name, ret)
+	}
+	elem := target.Elem()
+
+	fields := make([]reflect.StructField, 0, elem.NumField()+2)
+	for i := 0; i < elem.NumField(); i++ {
+		field := elem.Type().Field(i)
+		if !field.IsExported() || field.Name == "IgnorePaths" || field.Name == "Severity" {
+			// The config cannot be extended, so the rule block is decoded as is
+			return r.Runner.DecodeRuleConfig(name, ret)
+		}
+		fields = append(fields, field)
+	}
+	fields = append(fields, reflect.StructField{
+		Name: "IgnorePaths",
+		Type: reflect.TypeOf([]string{}),
+		Tag:  `hclext:"ignore_paths,optional"`,
+	}, reflect.StructField{
+		Name: "Severity",
+		Type: reflect.TypeOf(""),
+		Tag:  `hclext:"severity,optional"`,
+	})
+
+	extended := reflect.New(reflect.StructOf(fields)).Elem()
+	for i := 0; i < elem.NumField(); i++ {
+		extended.Field(i).Set(elem.Field(i))
+	}
+	if err := r.Runner.DecodeRuleConfig(name, extended.Addr().Interface()); err != nil {
+		return err
+	}
+	for i := 0; i < elem.NumField(); i++ {
+		elem.Field(i).Set(extended.Field(i))
+	}
+
+	globs := extended.Field(elem.NumField()).Interface().([]string)
+	patterns := make([]*regexp.Regexp, 0, len(globs))
+	for _, glob := range globs {
+		pattern, err := compileGlob(glob)
+		if err != nil {
+			return fmt.Errorf("invalid ignore_paths glob %q: %w", glob, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	if r.ruleIgnorePaths == nil {
+		r.ruleIgnorePaths = map[string][]*regexp.Regexp{}
+	}
+	r.ruleIgnorePaths[name] = patterns
+
+	if value := extended.Field(elem.NumField() + 1).String(); value != "" {
+		severity, err := toSeverity(value)
+		if err != nil {
+			return fmt.Errorf("severity: %w", err)
+		}
+		if r.ruleSeverities == nil {
+			r.ruleSeverities = map[string]tflint.Severity{}
+		}
+		r.ruleSeverities[name] = severity
+	}
+	return nil
+}
+
+// EmitIssue emits the issue unless its file is ignored
+func (r *globalRunner) EmitIssue(rule tflint.Rule, message string, issueRange hcl.Range) error {
+	ignored, err := r.ignored(rule, issueRange.Filename)
+	if ignored || err != nil {
+		return err
+	}
+	return r.Runner.EmitIssue(r.override(rule), message, issueRange)
+}
+
+// EmitIssueWithFix emits the issue with a fix unless its file is ignored
+func (r *globalRunner) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fixFunc func(f tflint.Fixer) error) error {
+	ignored, err := r.ignored(rule, issueRange.Filename)
+	if ignored || err != nil {
+		return err
+	}
+	return r.Runner.EmitIssueWithFix(r.override(rule), message, issueRange, fixFunc)
+}
+
+// ignored returns whether the file matches the global ignore_paths or those of the rule block.
+// Rules without options never decode their block, so it is decoded here the first time they emit an issue,
+// which also picks up the severity of the rule block.
+func (r *globalRunner) ignored(rule tflint.Rule, filename string) (bool, error) {
+	if _, decoded := r.ruleIgnorePaths[rule.Name()]; !decoded {
+		if err := r.DecodeRuleConfig(rule.Name(), &struct{}{}); err != nil {
+			return false, err
+		}
+	}
+
+	name := filepath.ToSlash(filepath.Clean(filename))
+	for _, patterns := range [][]*regexp.Regexp{r.ignorePaths, r.ruleIgnorePaths[rule.Name()]} {
+		for _, pattern := range patterns {
+			if pattern.MatchString(name) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// override returns the rule with the severity of its rule block or of severity_overrides, if any.
+// Rule blocks can only be decoded through a runner, so the severity is replaced per issue instead of by
+// the rules themselves, and Severity() of a rule always returns its default.
+func (r *globalRunner) override(rule tflint.Rule) tflint.Rule {
+	if severity, exists := r.ruleSeverities[rule.Name()]; exists {
+		return &severityOverride{Rule: rule, severity: severity}
+	}
+	if severity, exists := r.severities[rule.Name()]; exists {
+		return &severityOverride{Rule: rule, severity: severity}
+	}
+	return rule
+}
+
+// severityOverride is a rule whose severity is replaced by the config
+type severityOverride struct {
+	tflint.Rule
+	severity tflint.Severity
+}
+
+// Severity returns the overridden severity
+func (r *severityOverride) Severity() tflint.Severity {
+	return r.severity
+}
+
+// globalConfig returns the global config the runner was created with, or an empty config
+// when the runner was not created by the ruleset, as in tests
+func globalConfig(runner tflint.Runner) *GlobalConfig {
+	if r, ok := runner.(*globalRunner); ok {
+		return r.config
+	}
+	return &GlobalConfig{}
+}
+
+// namingDefaults returns the naming format and custom regex rules should use when their own config does not set one
+func namingDefaults(runner tflint.Runner) (string, string) {
+	config := globalConfig(runner)
+	if config.NamingFormat == "" {
+		return "snake_case", ""
+	}
+	return config.NamingFormat, config.NamingCustom
+}
+
+// compileGlob compiles a slash-separated path glob where * matches within a directory and ** matches across directories
+func compileGlob(glob string) (*regexp.Regexp, error) {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	glob = filepath.ToSlash(filepath.Clean(glob))
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; {
+		case strings.HasPrefix(glob[i:], "**/"):
+			pattern.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			pattern.WriteString(".*")
+			i++
+		case c == '*':
+			pattern.WriteString("[^/]*")
+		case c == '?':
+			pattern.WriteString("[^/]")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	pattern.WriteString("$")
+	return regexp.Compile(pattern.String())
+}
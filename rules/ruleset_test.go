@@ -0,0 +1,385 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// applyConfig applies the plugin block body to the ruleset
+func applyConfig(t *testing.T, ruleset *RuleSet, src string) error {
+	t.Helper()
+
+	file, diags := hclsyntax.ParseConfig([]byte(src), "plugin.hcl", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("Unexpected error occurred: %s", diags)
+	}
+	content, diags := hclext.Content(file.Body, ruleset.ConfigSchema())
+	if diags.HasErrors() {
+		t.Fatalf("Unexpected error occurred: %s", diags)
+	}
+	return ruleset.ApplyConfig(content)
+}
+
+func Test_RuleSet(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name:   "no global config",
+			Config: "",
+			Content: map[string]string{
+				"variables.tf": `
+variable "fooBar" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableNamingConventionRule(),
+					Message: `variable name "fooBar" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name:   "global naming format",
+			Config: `naming_format = "camelCase"`,
+			Content: map[string]string{
+				"variables.tf": `
+variable "fooBar" {}
+variable "foo_bar" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableNamingConventionRule(),
+					Message: `variable name "foo_bar" must match the following format: camelCase`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name:   "rule config takes precedence",
+			Config: `naming_format = "camelCase"`,
+			Content: map[string]string{
+				"variables.tf": `
+variable "fooBar" {}
+`,
+				".tflint.hcl": `
+rule "variable_naming_convention" {
+  enabled = true
+  format  = "snake_case"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableNamingConventionRule(),
+					Message: `variable name "fooBar" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name:   "ignore paths",
+			Config: `ignore_paths = ["legacy/**", "**/generated.tf"]`,
+			Content: map[string]string{
+				"legacy/old/variables.tf": `
+variable "fooBar" {}
+`,
+				"generated.tf": `
+variable "fooBar" {}
+`,
+				"modules/a/generated.tf": `
+variable "fooBar" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "rule ignore paths",
+			Content: map[string]string{
+				"legacy/variables.tf": `
+variable "fooBar" {}
+`,
+				"variables.tf": `
+variable "fooBar" {}
+`,
+				".tflint.hcl": `
+rule "variable_naming_convention" {
+  enabled      = true
+  format       = "snake_case"
+  ignore_paths = ["legacy/**"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableNamingConventionRule(),
+					Message: `variable name "fooBar" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "severity overrides",
+			Config: `
+severity_overrides = {
+  variable_naming_convention = "error"
+}
+`,
+			Content: map[string]string{
+				"variables.tf": `
+variable "fooBar" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    &severityOverride{Rule: NewVariableNamingConventionRule(), severity: tflint.ERROR},
+					Message: `variable name "fooBar" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "rule severity",
+			Config: `
+severity_overrides = {
+  variable_naming_convention = "error"
+}
+`,
+			Content: map[string]string{
+				"variables.tf": `
+variable "fooBar" {}
+`,
+				".tflint.hcl": `
+rule "variable_naming_convention" {
+  enabled  = true
+  severity = "notice"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    &severityOverride{Rule: NewVariableNamingConventionRule(), severity: tflint.NOTICE},
+					Message: `variable name "fooBar" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ruleset := &RuleSet{BuiltinRuleSet: tflint.BuiltinRuleSet{
+				Rules: []tflint.Rule{NewVariableNamingConventionRule()},
+			}}
+			if err := applyConfig(t, ruleset, tc.Config); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			runner := helper.TestRunner(t, tc.Content)
+			wrapped, err := ruleset.NewRunner(runner)
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if err := ruleset.Rules[0].Check(wrapped); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			for i, issue := range runner.Issues {
+				if issue.Rule.Severity() != tc.Expected[i].Rule.Severity() {
+					t.Errorf("Expected severity %s, got %s", tc.Expected[i].Rule.Severity(), issue.Rule.Severity())
+				}
+			}
+		})
+	}
+}
+
+func Test_RuleSet_ApplyConfig(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Config string
+		Error  string
+	}{
+		{
+			Name:   "invalid naming format",
+			Config: `naming_format = "UPPER"`,
+			Error:  `invalid naming_format: "UPPER" is an invalid format. Valid formats are snake_case, camelCase, PascalCase, kebab-case, and custom`,
+		},
+		{
+			Name:   "unknown rule",
+			Config: `severity_overrides = { unknown_rule = "ERROR" }`,
+			Error:  `severity_overrides: rule "unknown_rule" is not defined in this ruleset`,
+		},
+		{
+			Name:   "invalid preset",
+			Config: `preset = "paranoid"`,
+			Error:  `"paranoid" is an invalid preset. Valid presets are minimal, recommended, strict`,
+		},
+		{
+			Name:   "invalid severity",
+			Config: `severity_overrides = { variable_naming_convention = "FATAL" }`,
+			Error:  `severity_overrides: "FATAL" is an invalid severity. Valid values are ERROR, WARNING, and NOTICE`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ruleset := &RuleSet{BuiltinRuleSet: tflint.BuiltinRuleSet{
+				Rules: []tflint.Rule{NewVariableNamingConventionRule()},
+			}}
+
+			err := applyConfig(t, ruleset, tc.Config)
+			if err == nil {
+				t.Fatal("Expected an error, got nil")
+			}
+			if err.Error() != tc.Error {
+				t.Errorf("Expected error %q, got %q", tc.Error, err)
+			}
+		})
+	}
+}
+
+func Test_RuleSet_Preset(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Global   *tflint.Config
+		Config   string
+		Expected []string
+	}{
+		{
+			Name:     "defaults",
+			Global:   &tflint.Config{},
+			Config:   "",
+			Expected: []string{"unused_variable", "variable_naming_convention"},
+		},
+		{
+			Name:     "minimal",
+			Global:   &tflint.Config{},
+			Config:   `preset = "minimal"`,
+			Expected: []string{"unused_variable", "prevent_destroy_required"},
+		},
+		{
+			Name:     "strict",
+			Global:   &tflint.Config{},
+			Config:   `preset = "strict"`,
+			Expected: []string{"unused_variable", "variable_naming_convention", "prevent_destroy_required", "required_tags"},
+		},
+		{
+			Name: "rule blocks take precedence",
+			Global: &tflint.Config{
+				Rules: map[string]*tflint.RuleConfig{
+					"variable_naming_convention": {Name: "variable_naming_convention", Enabled: true},
+					"prevent_destroy_required":   {Name: "prevent_destroy_required", Enabled: false},
+				},
+			},
+			Config:   `preset = "minimal"`,
+			Expected: []string{"unused_variable", "variable_naming_convention"},
+		},
+		{
+			Name:     "only takes precedence",
+			Global:   &tflint.Config{Only: []string{"required_tags"}},
+			Config:   `preset = "minimal"`,
+			Expected: []string{"required_tags"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ruleset := &RuleSet{BuiltinRuleSet: tflint.BuiltinRuleSet{
+				Rules: []tflint.Rule{
+					NewUnusedVariableRule(),
+					NewVariableNamingConventionRule(),
+					NewPreventDestroyRequiredRule(),
+					NewRequiredTagsRule(),
+				},
+			}}
+			if err := ruleset.ApplyGlobalConfig(tc.Global); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if err := applyConfig(t, ruleset, tc.Config); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			got := make([]string, 0, len(ruleset.EnabledRules))
+			for _, rule := range ruleset.EnabledRules {
+				got = append(got, rule.Name())
+			}
+			if strings.Join(got, ",") != strings.Join(tc.Expected, ",") {
+				t.Errorf("Expected enabled rules %v, got %v", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func Test_globalRunner_RuleIgnorePathsWithoutOptions(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"legacy/main.tf": `
+resource "aws_instance" "legacy" {
+  for_each = toset(range(2))
+}
+`,
+		"main.tf": `
+resource "aws_instance" "web" {
+  for_each = toset(range(2))
+}
+`,
+		".tflint.hcl": `
+rule "for_each_stable_keys" {
+  enabled      = true
+  ignore_paths = ["legacy/*.tf"]
+}
+`,
+	})
+
+	rule := NewForEachStableKeysRule()
+	if err := rule.Check(&globalRunner{Runner: runner, config: &GlobalConfig{}}); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	helper.AssertIssues(t, helper.Issues{
+		{
+			Rule:    rule,
+			Message: `for_each of resource "aws_instance" "web" iterates over range(), use a map with stable keys so instances are not recreated when the range changes`,
+			Range: hcl.Range{
+				Filename: "main.tf",
+				Start:    hcl.Pos{Line: 3, Column: 14},
+				End:      hcl.Pos{Line: 3, Column: 29},
+			},
+		},
+	}, runner.Issues)
+}
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
@@ -13,9 +14,19 @@ const (
 	filenameMain      = "main.tf"
 	filenameVariables = "variables.tf"
 	filenameOutputs   = "outputs.tf"
-	filenameReadme	  = "README.md"
+	filenameReadme    = "README.md"
 )
 
+// standardModuleStructureRuleConfig is the config structure for the standard_module_structure rule
+type standardModuleStructureRuleConfig struct {
+	MainFile                string   `hclext:"main_file,optional"`
+	VariablesFile           string   `hclext:"variables_file,optional"`
+	OutputsFile             string   `hclext:"outputs_file,optional"`
+	ReadmeFile              string   `hclext:"readme_file,optional"`
+	AdditionalRequiredFiles []string `hclext:"additional_required_files,optional"`
+	IncludeChildModules     bool     `hclext:"include_child_modules,optional"`
+}
+
 // StandardModuleStructureRule checks whether modules adhere to Terraform's standard module structure
 type StandardModuleStructureRule struct {
 	tflint.DefaultRule
@@ -41,14 +52,50 @@ func (r *StandardModuleStructureRule) Severity() tflint.Severity {
 	return tflint.WARNING
 }
 
+// Link returns the rule reference link
+func (r *StandardModuleStructureRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *StandardModuleStructureRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Ensure that a module complies with the Terraform Standard Module Structure",
+		Tags:        []string{TagStructure},
+		Config: []ConfigOption{
+			{Name: "main_file", Description: "Name of the primary entrypoint file", Default: "main.tf"},
+			{Name: "variables_file", Description: "Name of the file declaring variables", Default: "variables.tf"},
+			{Name: "outputs_file", Description: "Name of the file declaring outputs", Default: "outputs.tf"},
+			{Name: "readme_file", Description: "Name of the README file", Default: "README.md"},
+			{Name: "additional_required_files", Description: "Additional files every module must include", Default: "[]"},
+			{Name: "include_child_modules", Description: "Also inspect local modules called from the root module, directly or indirectly, and report their problems at the calling module block", Default: "false"},
+		},
+		Example: `
+# main.tf
+variable "region" {}
+`,
+	}
+}
+
 // Check emits errors for any missing files and any block types that are included in the wrong file
 func (r *StandardModuleStructureRule) Check(runner tflint.Runner) error {
+	config := &standardModuleStructureRuleConfig{
+		MainFile:      filenameMain,
+		VariablesFile: filenameVariables,
+		OutputsFile:   filenameOutputs,
+		ReadmeFile:    filenameReadme,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
 	path, err := runner.GetModulePath()
 	if err != nil {
 		return err
 	}
 	if !path.IsRoot() {
-		// This rule does not evaluate child modules.
+		// This rule does not evaluate child modules. TFLint drops issues that are not tied to module arguments there,
+		// so include_child_modules follows local module calls from the root module instead.
 		return nil
 	}
 
@@ -61,41 +108,101 @@ func (r *StandardModuleStructureRule) Check(runner tflint.Runner) error {
 		return nil
 	}
 
-	body, err := runner.GetModuleContent(&hclext.BodySchema{
-		Blocks: []hclext.BlockSchema{
-			{
-				Type:       "variable",
-				LabelNames: []string{"name"},
-				Body:       &hclext.BodySchema{},
-			},
-			{
-				Type:       "output",
-				LabelNames: []string{"name"},
-				Body:       &hclext.BodySchema{},
-			},
-		},
-	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	body, err := runner.GetModuleContent(standardModuleStructureSchema, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
 	if err != nil {
 		return err
 	}
 
 	blocks := body.Blocks.ByType()
 
-	if err := r.checkFiles(runner, body.Blocks); err != nil {
+	if err := r.checkFiles(runner, body.Blocks, config); err != nil {
+		return err
+	}
+	if err := r.checkVariables(runner, blocks["variable"], config.VariablesFile); err != nil {
 		return err
 	}
-	if err := r.checkVariables(runner, blocks["variable"]); err != nil {
+	if err := r.checkOutputs(runner, blocks["output"], config.OutputsFile); err != nil {
 		return err
 	}
-	if err := r.checkOutputs(runner, blocks["output"]); err != nil {
+
+	if !config.IncludeChildModules {
+		return nil
+	}
+	modules, err := localCalledModules(runner)
+	if err != nil {
 		return err
 	}
+	for _, module := range modules {
+		if err := r.checkCalledModule(runner, module, config); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
-func (r *StandardModuleStructureRule) checkFiles(runner tflint.Runner, blocks hclext.Blocks) error {
-	onlyJSON, err := r.onlyJSON(runner)
+// standardModuleStructureSchema extracts the blocks whose placement the rule checks
+var standardModuleStructureSchema = &hclext.BodySchema{
+	Blocks: []hclext.BlockSchema{
+		{
+			Type:       "variable",
+			LabelNames: []string{"name"},
+			Body:       &hclext.BodySchema{},
+		},
+		{
+			Type:       "output",
+			LabelNames: []string{"name"},
+			Body:       &hclext.BodySchema{},
+		},
+	},
+}
+
+// missingFile is a file the module should include, with the message reported for it
+type missingFile struct {
+	name    string
+	message string
+}
+
+// missingFiles returns the files a module is missing. subject names the module in messages.
+func missingFiles(subject string, exists func(name string) bool, blocks hclext.Blocks, config *standardModuleStructureRuleConfig) []missingFile {
+	missing := []missingFile{}
+	if !exists(config.MainFile) {
+		missing = append(missing, missingFile{
+			name:    config.MainFile,
+			message: fmt.Sprintf("%s should include a %s file as the primary entrypoint", subject, config.MainFile),
+		})
+	}
+	if !exists(config.ReadmeFile) {
+		missing = append(missing, missingFile{
+			name:    config.ReadmeFile,
+			message: fmt.Sprintf("%s should include a %s file with a comprehensive description of the module", subject, config.ReadmeFile),
+		})
+	}
+	if !exists(config.VariablesFile) && len(blocks.ByType()["variable"]) == 0 {
+		missing = append(missing, missingFile{
+			name:    config.VariablesFile,
+			message: fmt.Sprintf("%s should include an empty %s file", subject, config.VariablesFile),
+		})
+	}
+	if !exists(config.OutputsFile) && len(blocks.ByType()["output"]) == 0 {
+		missing = append(missing, missingFile{
+			name:    config.OutputsFile,
+			message: fmt.Sprintf("%s should include an empty %s file", subject, config.OutputsFile),
+		})
+	}
+	for _, name := range config.AdditionalRequiredFiles {
+		if !exists(name) {
+			missing = append(missing, missingFile{
+				name:    name,
+				message: fmt.Sprintf("%s should include a %s file", subject, name),
+			})
+		}
+	}
+	return missing
+}
+
+func (r *StandardModuleStructureRule) checkFiles(runner tflint.Runner, blocks hclext.Blocks, config *standardModuleStructureRuleConfig) error {
+	onlyJSON, err := onlyJSON(runner)
 	if err != nil {
 		return err
 	}
@@ -103,79 +210,75 @@ func (r *StandardModuleStructureRule) checkFiles(runner tflint.Runner, blocks hc
 		return nil
 	}
 
-	f, err := runner.GetFiles()
+	dir, files, err := moduleFiles(runner)
 	if err != nil {
 		return err
 	}
 
-	var dir string
-	files := make(map[string]*hcl.File, len(f))
-	for name, file := range f {
-		dir = filepath.Dir(name)
-		files[filepath.Base(name)] = file
-	}
-
-	if files[filenameMain] == nil {
+	exists := func(name string) bool { return files[name] != nil }
+	for _, missing := range missingFiles("Module", exists, blocks, config) {
 		if err := runner.EmitIssue(
 			r,
-			fmt.Sprintf("Module should include a %s file as the primary entrypoint", filenameMain),
+			missing.message,
 			hcl.Range{
-				Filename: filepath.Join(dir, filenameMain),
+				Filename: filepath.Join(dir, missing.name),
 				Start:    hcl.InitialPos,
 			},
 		); err != nil {
 			return err
 		}
 	}
+	return nil
+}
+
+// checkCalledModule reports the problems of a local module called from the root module at the calling module block.
+// Called modules are read from the filesystem, so misplaced blocks are reported without a fix.
+func (r *StandardModuleStructureRule) checkCalledModule(runner tflint.Runner, module *calledModule, config *standardModuleStructureRuleConfig) error {
+	body, err := module.content(standardModuleStructureSchema)
+	if err != nil {
+		return err
+	}
 
-	if files[filenameReadme] == nil {
-		if err := runner.EmitIssue(
-			r,
-			fmt.Sprintf("Module should include a %s file with a comprehensive description of the module", filenameReadme),
-			hcl.Range{
-				Filename: filepath.Join(dir, filenameReadme),
-				Start:    hcl.InitialPos,
-			},
-		); err != nil {
-			return err
+	messages := []string{}
+	json := true
+	for name := range module.files {
+		if filepath.Ext(name) != ".json" {
+			json = false
+		}
+	}
+	if !json {
+		exists := func(name string) bool { return anyFileExists(module.dir, []string{name}) }
+		for _, missing := range missingFiles(fmt.Sprintf("Module %s", module.addr), exists, body.Blocks, config) {
+			messages = append(messages, missing.message)
 		}
 	}
 
-	if files[filenameVariables] == nil && len(blocks.ByType()["variable"]) == 0 {
-		if err := runner.EmitIssue(
-			r,
-			fmt.Sprintf("Module should include an empty %s file", filenameVariables),
-			hcl.Range{
-				Filename: filepath.Join(dir, filenameVariables),
-				Start:    hcl.InitialPos,
-			},
-		); err != nil {
-			return err
+	for _, block := range body.Blocks {
+		expected := config.VariablesFile
+		if block.Type == "output" {
+			expected = config.OutputsFile
+		}
+		if filename := block.DefRange.Filename; shouldMove(filename, expected) {
+			messages = append(messages, fmt.Sprintf("%s %q in %s should be moved from %s to %s", block.Type, block.Labels[0], module.addr, filepath.Base(filename), expected))
 		}
 	}
 
-	if files[filenameOutputs] == nil && len(blocks.ByType()["output"]) == 0 {
-		if err := runner.EmitIssue(
-			r,
-			fmt.Sprintf("Module should include an empty %s file", filenameOutputs),
-			hcl.Range{
-				Filename: filepath.Join(dir, filenameOutputs),
-				Start:    hcl.InitialPos,
-			},
-		); err != nil {
+	for _, message := range messages {
+		if err := runner.EmitIssue(r, message, module.callRange); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (r *StandardModuleStructureRule) checkVariables(runner tflint.Runner, variables hclext.Blocks) error {
+func (r *StandardModuleStructureRule) checkVariables(runner tflint.Runner, variables hclext.Blocks, expected string) error {
 	for _, variable := range variables {
-		if filename := variable.DefRange.Filename; r.shouldMove(filename, filenameVariables) {
-			if err := runner.EmitIssue(
+		if filename := variable.DefRange.Filename; shouldMove(filename, expected) {
+			if err := runner.EmitIssueWithFix(
 				r,
-				fmt.Sprintf("variable %q should be moved from %s to %s", variable.Labels[0], filename, filenameVariables),
+				fmt.Sprintf("variable %q should be moved from %s to %s", variable.Labels[0], filename, expected),
 				variable.DefRange,
+				moveBlockFix(runner, variable, expected),
 			); err != nil {
 				return err
 			}
@@ -184,13 +287,14 @@ func (r *StandardModuleStructureRule) checkVariables(runner tflint.Runner, varia
 	return nil
 }
 
-func (r *StandardModuleStructureRule) checkOutputs(runner tflint.Runner, outputs hclext.Blocks) error {
+func (r *StandardModuleStructureRule) checkOutputs(runner tflint.Runner, outputs hclext.Blocks, expected string) error {
 	for _, output := range outputs {
-		if filename := output.DefRange.Filename; r.shouldMove(filename, filenameOutputs) {
-			if err := runner.EmitIssue(
+		if filename := output.DefRange.Filename; shouldMove(filename, expected) {
+			if err := runner.EmitIssueWithFix(
 				r,
-				fmt.Sprintf("output %q should be moved from %s to %s", output.Labels[0], filename, filenameOutputs),
+				fmt.Sprintf("output %q should be moved from %s to %s", output.Labels[0], filename, expected),
 				output.DefRange,
+				moveBlockFix(runner, output, expected),
 			); err != nil {
 				return err
 			}
@@ -198,31 +302,3 @@ func (r *StandardModuleStructureRule) checkOutputs(runner tflint.Runner, outputs
 	}
 	return nil
 }
-
-func (r *StandardModuleStructureRule) onlyJSON(runner tflint.Runner) (bool, error) {
-	files, err := runner.GetFiles()
-	if err != nil {
-		return false, err
-	}
-
-	if len(files) == 0 {
-		return false, nil
-	}
-
-	for filename := range files {
-		if filepath.Ext(filename) != ".json" {
-			return false, nil
-		}
-	}
-
-	return true, nil
-}
-
-func (r *StandardModuleStructureRule) shouldMove(path string, expected string) bool {
-	// json files are likely generated and conventional filenames do not apply
-	if filepath.Ext(path) == ".json" {
-		return false
-	}
-
-	return filepath.Base(path) != expected
-}
\ No newline at end of file
@@ -13,6 +13,7 @@ func Test_StandardModuleStructureRule(t *testing.T) {
 		Name     string
 		Content  map[string]string
 		Expected helper.Issues
+		Fixed    map[string]string
 	}{
 		{
 			Name:     "empty module",
@@ -106,6 +107,12 @@ variable "v" {}
 					},
 				},
 			},
+			Fixed: map[string]string{
+				"main.tf": `
+`,
+				"variables.tf": `variable "v" {}
+`,
+			},
 		},
 		{
 			Name: "move output",
@@ -156,6 +163,71 @@ output "o" { value = null }
 			},
 			Expected: helper.Issues{},
 		},
+		{
+			Name: "custom filenames",
+			Content: map[string]string{
+				"main.tf": `
+variable "v" {}
+output "o" { value = null }
+`,
+				"inputs.tf":  "",
+				"exports.tf": "",
+				"README.md":  "",
+				".tflint.hcl": `
+rule "standard_module_structure" {
+  enabled        = true
+  variables_file = "inputs.tf"
+  outputs_file   = "exports.tf"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewStandardModuleStructureRule(),
+					Message: `variable "v" should be moved from main.tf to inputs.tf`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 13},
+					},
+				},
+				{
+					Rule:    NewStandardModuleStructureRule(),
+					Message: `output "o" should be moved from main.tf to exports.tf`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 11},
+					},
+				},
+			},
+		},
+		{
+			Name: "additional required files",
+			Content: map[string]string{
+				"main.tf":      "",
+				"variables.tf": "",
+				"outputs.tf":   "",
+				"README.md":    "",
+				"versions.tf":  "",
+				".tflint.hcl": `
+rule "standard_module_structure" {
+  enabled                   = true
+  additional_required_files = ["versions.tf", "providers.tf"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewStandardModuleStructureRule(),
+					Message: "Module should include a providers.tf file",
+					Range: hcl.Range{
+						Filename: "providers.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+			},
+		},
 	}
 
 	rule := NewStandardModuleStructureRule()
@@ -169,6 +241,106 @@ output "o" { value = null }
 			}
 
 			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			if tc.Fixed != nil {
+				helper.AssertChanges(t, tc.Fixed, runner.Changes())
+			}
+		})
+	}
+}
+
+func Test_StandardModuleStructureRule_ChildModules(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   string
+		Expected func(filename string) helper.Issues
+	}{
+		{
+			Name:     "child modules are skipped by default",
+			Expected: func(filename string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "include child modules",
+			Config: `
+rule "standard_module_structure" {
+  enabled               = true
+  include_child_modules = true
+}
+`,
+			Expected: func(filename string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewStandardModuleStructureRule(),
+						Message: "Module module.network should include a README.md file with a comprehensive description of the module",
+						Range: hcl.Range{
+							Filename: filename,
+							Start:    hcl.Pos{Line: 2, Column: 1},
+							End:      hcl.Pos{Line: 2, Column: 17},
+						},
+					},
+					{
+						Rule:    NewStandardModuleStructureRule(),
+						Message: `variable "v" in module.network should be moved from foo.tf to variables.tf`,
+						Range: hcl.Range{
+							Filename: filename,
+							Start:    hcl.Pos{Line: 2, Column: 1},
+							End:      hcl.Pos{Line: 2, Column: 17},
+						},
+					},
+					{
+						Rule:    NewStandardModuleStructureRule(),
+						Message: "Module module.network.module.subnets should include an empty outputs.tf file",
+						Range: hcl.Range{
+							Filename: filename,
+							Start:    hcl.Pos{Line: 2, Column: 1},
+							End:      hcl.Pos{Line: 2, Column: 17},
+						},
+					},
+				}
+			},
+		},
+	}
+
+	rule := NewStandardModuleStructureRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, map[string]string{
+				"modules/network/main.tf":              `module "subnets" { source = "./subnets" }`,
+				"modules/network/variables.tf":         "",
+				"modules/network/outputs.tf":           "",
+				"modules/network/foo.tf":               `variable "v" {}`,
+				"modules/network/subnets/main.tf":      "",
+				"modules/network/subnets/variables.tf": "",
+				"modules/network/subnets/README.md":    "",
+				"modules/remote/main.tf":               "",
+			})
+
+			filename := filepath.Join(dir, "main.tf")
+			content := map[string]string{
+				filename: `
+module "network" {
+  source = "./modules/network"
+}
+
+module "vpc" {
+  source = "terraform-aws-modules/vpc/aws"
+}
+`,
+				filepath.Join(dir, "variables.tf"): "",
+				filepath.Join(dir, "outputs.tf"):   "",
+				filepath.Join(dir, "README.md"):    "",
+			}
+			if tc.Config != "" {
+				content[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(filename), runner.Issues)
 		})
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// staticDynamicBlockRuleConfig is the config structure for the static_dynamic_block rule
+type staticDynamicBlockRuleConfig struct {
+	MinElements int `hclext:"min_elements,optional"`
+}
+
+// StaticDynamicBlockRule checks whether dynamic blocks iterate over constant collections
+type StaticDynamicBlockRule struct {
+	tflint.DefaultRule
+}
+
+// NewStaticDynamicBlockRule returns a new rule
+func NewStaticDynamicBlockRule() *StaticDynamicBlockRule {
+	return &StaticDynamicBlockRule{}
+}
+
+// Name returns the rule name
+func (r *StaticDynamicBlockRule) Name() string {
+	return "static_dynamic_block"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *StaticDynamicBlockRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *StaticDynamicBlockRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *StaticDynamicBlockRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *StaticDynamicBlockRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow dynamic blocks whose for_each is a constant collection, in favour of plain nested blocks",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "min_elements", Description: "Minimum number of elements of a constant for_each to report", Default: "1"},
+		},
+		Example: `
+resource "aws_security_group" "web" {
+  dynamic "ingress" {
+    for_each = [80, 443]
+    content {
+      from_port   = ingress.value
+      to_port     = ingress.value
+      protocol    = "tcp"
+      cidr_blocks = ["0.0.0.0/0"]
+    }
+  }
+}
+`,
+	}
+}
+
+// Check emits an issue for each dynamic block whose for_each references nothing and evaluates to a collection
+// of at least min_elements elements
+func (r *StaticDynamicBlockRule) Check(runner tflint.Runner) error {
+	config := &staticDynamicBlockRuleConfig{MinElements: 1}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			// JSON files are likely generated
+			continue
+		}
+		if err := r.checkBody(runner, body, config.MinElements); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *StaticDynamicBlockRule) checkBody(runner tflint.Runner, body *hclsyntax.Body, minElements int) error {
+	for _, block := range body.Blocks {
+		if err := r.checkBody(runner, block.Body, minElements); err != nil {
+			return err
+		}
+		if block.Type != "dynamic" || len(block.Labels) != 1 {
+			continue
+		}
+
+		forEach, exists := block.Body.Attributes["for_each"]
+		if !exists || len(forEach.Expr.Variables()) > 0 {
+			continue
+		}
+		value, diags := forEach.Expr.Value(nil)
+		if diags.HasErrors() || !value.IsWhollyKnown() || value.IsNull() || !value.CanIterateElements() {
+			continue
+		}
+		if value.LengthInt() < minElements {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("dynamic block %q iterates over a constant collection, write the %q blocks out instead", block.Labels[0], block.Labels[0]),
+			block.DefRange(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
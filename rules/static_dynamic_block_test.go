@@ -0,0 +1,127 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_StaticDynamicBlockRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "variable for_each",
+			Content: `
+resource "aws_security_group" "web" {
+  dynamic "ingress" {
+    for_each = var.ports
+    content {
+      from_port = ingress.value
+      to_port   = ingress.value
+    }
+  }
+
+  dynamic "egress" {
+    for_each = toset([for port in var.ports : port])
+    content {
+      from_port = egress.value
+    }
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "constant for_each",
+			Content: `
+resource "aws_security_group" "web" {
+  dynamic "ingress" {
+    for_each = [80, 443]
+    content {
+      from_port = ingress.value
+      to_port   = ingress.value
+    }
+  }
+}
+
+resource "aws_lb_listener" "web" {
+  default_action {
+    type = "forward"
+
+    dynamic "forward" {
+      for_each = { blue = "tg-blue" }
+      content {
+        target_group {
+          arn = forward.value
+        }
+      }
+    }
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewStaticDynamicBlockRule(),
+					Message: `dynamic block "ingress" iterates over a constant collection, write the "ingress" blocks out instead`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 20},
+					},
+				},
+				{
+					Rule:    NewStaticDynamicBlockRule(),
+					Message: `dynamic block "forward" iterates over a constant collection, write the "forward" blocks out instead`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 16, Column: 5},
+						End:      hcl.Pos{Line: 16, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "min_elements",
+			Content: `
+resource "aws_security_group" "web" {
+  dynamic "ingress" {
+    for_each = [80, 443]
+    content {
+      from_port = ingress.value
+    }
+  }
+}
+`,
+			Config: `
+rule "static_dynamic_block" {
+  enabled      = true
+  min_elements = 3
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewStaticDynamicBlockRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"resource.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// tagValueFormatRuleConfig is the config structure for the tag_value_format rule
+type tagValueFormatRuleConfig struct {
+	Tags          map[string]string `hclext:"tags,optional"`
+	ResourceTypes []string          `hclext:"resource_types,optional"`
+}
+
+// TagValueFormatRule checks whether tag values match the configured formats
+type TagValueFormatRule struct {
+	tflint.DefaultRule
+}
+
+// NewTagValueFormatRule returns a new rule
+func NewTagValueFormatRule() *TagValueFormatRule {
+	return &TagValueFormatRule{}
+}
+
+// Name returns the rule name
+func (r *TagValueFormatRule) Name() string {
+	return "tag_value_format"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TagValueFormatRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TagValueFormatRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TagValueFormatRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TagValueFormatRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require tag values to match configured regular expressions",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "tags", Description: "Map of tag keys to regular expressions their values must match", Default: "{}"},
+			{Name: "resource_types", Description: "Regular expressions of resource types to check", Default: `["^aws_", "^azurerm_", "^google_"]`},
+		},
+		Example: `
+resource "aws_instance" "web" {
+  tags = {
+    CostCenter = "marketing"
+  }
+}
+`,
+	}
+}
+
+// Check emits an issue for every configured tag whose statically known value does not match its format.
+// Missing tags are left to the required_tags rule.
+func (r *TagValueFormatRule) Check(runner tflint.Runner) error {
+	config := &tagValueFormatRuleConfig{
+		ResourceTypes: []string{"^aws_", "^azurerm_", "^google_"},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if len(config.Tags) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(config.Tags))
+	formats := make(map[string]*regexp.Regexp, len(config.Tags))
+	for key, p := range config.Tags {
+		format, err := regexp.Compile(p)
+		if err != nil {
+			return fmt.Errorf("invalid format %q for tag %q: %w", p, key, err)
+		}
+		keys = append(keys, key)
+		formats[key] = format
+	}
+	sort.Strings(keys)
+
+	patterns := make([]*regexp.Regexp, 0, len(config.ResourceTypes))
+	for _, p := range config.ResourceTypes {
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return fmt.Errorf("invalid resource type pattern %q: %w", p, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "tags"}, {Name: "labels"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range body.Blocks {
+		if !matchesAny(patterns, resource.Labels[0]) {
+			continue
+		}
+
+		attr, exists := resource.Body.Attributes["tags"]
+		if !exists {
+			attr, exists = resource.Body.Attributes["labels"]
+		}
+		if !exists {
+			continue
+		}
+
+		values, known, err := tagValues(runnerEvaluator(runner), attr.Expr)
+		if err != nil {
+			return err
+		}
+		if !known {
+			continue
+		}
+
+		for _, key := range keys {
+			value, exists := values[key]
+			if !exists || !value.IsKnown() || value.IsNull() || value.Type() != cty.String {
+				continue
+			}
+			if formats[key].MatchString(value.AsString()) {
+				continue
+			}
+			rng, _ := tagValueRange(attr.Expr, key)
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf(`tag %q of resource "%s" "%s" has value %q, which does not match %s`, key, resource.Labels[0], resource.Labels[1], value.AsString(), formats[key]),
+				rng,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// tagValueRange returns the range of the value written for the key in a tags expression, looking into
+// object literals and merge() arguments. It returns the range of the whole expression if the value is not found.
+func tagValueRange(expr hcl.Expression, key string) (hcl.Range, bool) {
+	switch e := expr.(type) {
+	case *hclsyntax.ObjectConsExpr:
+		for i := len(e.Items) - 1; i >= 0; i-- {
+			if name, ok := objectKey(e.Items[i].KeyExpr); ok && name == key {
+				return e.Items[i].ValueExpr.Range(), true
+			}
+		}
+	case *hclsyntax.FunctionCallExpr:
+		if e.Name == "merge" {
+			// Later arguments take precedence
+			for i := len(e.Args) - 1; i >= 0; i-- {
+				if rng, ok := tagValueRange(e.Args[i], key); ok {
+					return rng, true
+				}
+			}
+		}
+	}
+	return expr.Range(), false
+}
+
+// objectKey returns the name of an object constructor key written as a bare word or a string literal
+func objectKey(expr hcl.Expression) (string, bool) {
+	if name := hcl.ExprAsKeyword(expr); name != "" {
+		return name, true
+	}
+	return literalString(expr)
+}
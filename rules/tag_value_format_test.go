@@ -0,0 +1,171 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TagValueFormatRule(t *testing.T) {
+	config := `
+rule "tag_value_format" {
+  enabled = true
+  tags = {
+    CostCenter  = "^CC-\\d{4}$"
+    Environment = "^(dev|stg|prd)$"
+  }
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no formats configured",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  tags = {
+    CostCenter = "marketing"
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "valid values",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  tags = {
+    CostCenter  = "CC-1234"
+    Environment = "prd"
+  }
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "invalid value",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  tags = {
+    CostCenter  = "marketing"
+    Environment = "prd"
+  }
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTagValueFormatRule(),
+					Message: `tag "CostCenter" of resource "aws_instance" "web" has value "marketing", which does not match ^CC-\d{4}$`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 19},
+						End:      hcl.Pos{Line: 4, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid values in merge",
+			Content: map[string]string{
+				"main.tf": `
+variable "common_tags" {
+  default = {
+    CostCenter = "CC-12"
+  }
+}
+resource "aws_instance" "web" {
+  tags = merge(var.common_tags, { "Environment" = "production" })
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTagValueFormatRule(),
+					Message: `tag "CostCenter" of resource "aws_instance" "web" has value "CC-12", which does not match ^CC-\d{4}$`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 10},
+						End:      hcl.Pos{Line: 8, Column: 66},
+					},
+				},
+				{
+					Rule:    NewTagValueFormatRule(),
+					Message: `tag "Environment" of resource "aws_instance" "web" has value "production", which does not match ^(dev|stg|prd)$`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 51},
+						End:      hcl.Pos{Line: 8, Column: 63},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown value",
+			Content: map[string]string{
+				"main.tf": `
+variable "cost_center" {}
+resource "aws_instance" "web" {
+  tags = {
+    CostCenter = var.cost_center
+  }
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing tag",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  tags = {}
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "other resource types",
+			Content: map[string]string{
+				"main.tf": `
+resource "random_id" "web" {
+  tags = {
+    CostCenter = "marketing"
+  }
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewTagValueFormatRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// terraformAllowedBackendsRuleConfig is the config structure for the terraform_allowed_backends rule
+type terraformAllowedBackendsRuleConfig struct {
+	AllowedBackends    []string            `hclext:"allowed_backends,optional"`
+	RequiredAttributes map[string][]string `hclext:"required_attributes,optional"`
+}
+
+// TerraformAllowedBackendsRule checks whether backend blocks use an allowed type with the required attributes
+type TerraformAllowedBackendsRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformAllowedBackendsRule returns a new rule
+func NewTerraformAllowedBackendsRule() *TerraformAllowedBackendsRule {
+	return &TerraformAllowedBackendsRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformAllowedBackendsRule) Name() string {
+	return "terraform_allowed_backends"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformAllowedBackendsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformAllowedBackendsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *TerraformAllowedBackendsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformAllowedBackendsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Restrict backend types and require attributes per backend type",
+		Tags:        []string{TagSecurity},
+		Config: []ConfigOption{
+			{Name: "allowed_backends", Description: "Backend types that may be used, empty allows every type", Default: "[]"},
+			{Name: "required_attributes", Description: "Map of backend types to attributes they must set. Attributes set to false are reported too", Default: "{}"},
+		},
+		Example: `
+terraform {
+  backend "local" {}
+}
+`,
+	}
+}
+
+// Check emits issues for backends of types that are not allowed, and for missing or disabled required attributes
+func (r *TerraformAllowedBackendsRule) Check(runner tflint.Runner) error {
+	config := &terraformAllowedBackendsRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if len(config.AllowedBackends) == 0 && len(config.RequiredAttributes) == 0 {
+		return nil
+	}
+
+	attributes := []hclext.AttributeSchema{}
+	seen := map[string]bool{}
+	for _, names := range config.RequiredAttributes {
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				attributes = append(attributes, hclext.AttributeSchema{Name: name})
+			}
+		}
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "terraform",
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type:       "backend",
+							LabelNames: []string{"type"},
+							Body:       &hclext.BodySchema{Attributes: attributes},
+						},
+					},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool, len(config.AllowedBackends))
+	for _, t := range config.AllowedBackends {
+		allowed[t] = true
+	}
+
+	for _, terraform := range body.Blocks {
+		for _, backend := range terraform.Body.Blocks {
+			backendType := backend.Labels[0]
+			if len(allowed) > 0 && !allowed[backendType] {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("backend type %q is not allowed, use one of: %s", backendType, strings.Join(config.AllowedBackends, ", ")),
+					backend.DefRange,
+				); err != nil {
+					return err
+				}
+				continue
+			}
+
+			required := append([]string{}, config.RequiredAttributes[backendType]...)
+			sort.Strings(required)
+			for _, name := range required {
+				attr, exists := backend.Body.Attributes[name]
+				if !exists {
+					if err := runner.EmitIssue(
+						r,
+						fmt.Sprintf("%s backend should set %q", backendType, name),
+						backend.DefRange,
+					); err != nil {
+						return err
+					}
+					continue
+				}
+
+				val, diags := attr.Expr.Value(nil)
+				if !diags.HasErrors() && val.IsKnown() && !val.IsNull() && val.Type() == cty.Bool && val.False() {
+					if err := runner.EmitIssue(
+						r,
+						fmt.Sprintf("%s backend should not set %q to false", backendType, name),
+						attr.Range,
+					); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformAllowedBackendsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no config",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  backend "local" {}
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "allowed backend",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  backend "s3" {}
+}
+`,
+				".tflint.hcl": `
+rule "terraform_allowed_backends" {
+  enabled          = true
+  allowed_backends = ["s3", "gcs"]
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "backend not allowed",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  backend "local" {}
+}
+`,
+				".tflint.hcl": `
+rule "terraform_allowed_backends" {
+  enabled          = true
+  allowed_backends = ["s3", "gcs"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformAllowedBackendsRule(),
+					Message: `backend type "local" is not allowed, use one of: s3, gcs`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing required attribute",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  backend "s3" {
+    bucket = "state"
+  }
+}
+`,
+				".tflint.hcl": `
+rule "terraform_allowed_backends" {
+  enabled = true
+  required_attributes = {
+    s3 = ["encrypt"]
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformAllowedBackendsRule(),
+					Message: `s3 backend should set "encrypt"`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 15},
+					},
+				},
+			},
+		},
+		{
+			Name: "required attribute set to false",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  backend "s3" {
+    encrypt = false
+  }
+}
+`,
+				".tflint.hcl": `
+rule "terraform_allowed_backends" {
+  enabled = true
+  required_attributes = {
+    s3 = ["encrypt"]
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformAllowedBackendsRule(),
+					Message: `s3 backend should not set "encrypt" to false`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 4, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "required attributes of other types",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  backend "gcs" {}
+}
+`,
+				".tflint.hcl": `
+rule "terraform_allowed_backends" {
+  enabled = true
+  required_attributes = {
+    s3 = ["encrypt"]
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewTerraformAllowedBackendsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const filenameData = "data.tf"
+
+// terraformDataFileRuleConfig is the config structure for the terraform_data_file rule
+type terraformDataFileRuleConfig struct {
+	Filename    string   `hclext:"filename,optional"`
+	ExemptTypes []string `hclext:"exempt_types,optional"`
+}
+
+// TerraformDataFileRule checks whether data sources live in data.tf
+type TerraformDataFileRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformDataFileRule returns a new rule
+func NewTerraformDataFileRule() *TerraformDataFileRule {
+	return &TerraformDataFileRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformDataFileRule) Name() string {
+	return "terraform_data_file"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformDataFileRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformDataFileRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformDataFileRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformDataFileRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Ensure that data sources live in data.tf",
+		Tags:        []string{TagStructure},
+		Config: []ConfigOption{
+			{Name: "filename", Description: "Name of the file that holds data sources", Default: "data.tf"},
+			{Name: "exempt_types", Description: "Data source types that may live in any file", Default: "[]"},
+		},
+		Example: `
+# main.tf
+data "aws_caller_identity" "current" {}
+`,
+	}
+}
+
+// Check emits issues for data sources declared outside the expected file, except exempt types
+func (r *TerraformDataFileRule) Check(runner tflint.Runner) error {
+	config := &terraformDataFileRuleConfig{Filename: filenameData}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	exempt := make(map[string]bool, len(config.ExemptTypes))
+	for _, t := range config.ExemptTypes {
+		exempt[t] = true
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "data",
+				LabelNames: []string{"type", "name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, data := range body.Blocks {
+		if exempt[data.Labels[0]] {
+			continue
+		}
+		if filename := data.DefRange.Filename; shouldMove(filename, config.Filename) {
+			if err := runner.EmitIssueWithFix(
+				r,
+				fmt.Sprintf("data %q should be moved from %s to %s", data.Labels[0]+"."+data.Labels[1], filename, config.Filename),
+				data.DefRange,
+				moveBlockFix(runner, data, config.Filename),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformDataFileRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "data in data.tf",
+			Content: map[string]string{
+				"data.tf": `
+data "aws_caller_identity" "current" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "move data",
+			Content: map[string]string{
+				"main.tf": `
+data "aws_caller_identity" "current" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformDataFileRule(),
+					Message: `data "aws_caller_identity.current" should be moved from main.tf to data.tf`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "exempt types and custom filename",
+			Content: map[string]string{
+				"main.tf": `
+data "aws_iam_policy_document" "assume" {}
+data "aws_caller_identity" "current" {}
+`,
+				".tflint.hcl": `
+rule "terraform_data_file" {
+  enabled      = true
+  filename     = "lookups.tf"
+  exempt_types = ["aws_iam_policy_document"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformDataFileRule(),
+					Message: `data "aws_caller_identity.current" should be moved from main.tf to lookups.tf`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 37},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformDataFileRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const filenameLocals = "locals.tf"
+
+// terraformLocalsFileRuleConfig is the config structure for the terraform_locals_file rule
+type terraformLocalsFileRuleConfig struct {
+	Filename string `hclext:"filename,optional"`
+}
+
+// TerraformLocalsFileRule checks whether locals blocks live in locals.tf
+type TerraformLocalsFileRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformLocalsFileRule returns a new rule
+func NewTerraformLocalsFileRule() *TerraformLocalsFileRule {
+	return &TerraformLocalsFileRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformLocalsFileRule) Name() string {
+	return "terraform_locals_file"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformLocalsFileRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformLocalsFileRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformLocalsFileRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformLocalsFileRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Ensure that locals blocks live in locals.tf",
+		Tags:        []string{TagStructure},
+		Config: []ConfigOption{
+			{Name: "filename", Description: "Name of the file that holds locals blocks", Default: "locals.tf"},
+		},
+		Example: `
+# main.tf
+locals {
+  name = "app"
+}
+`,
+	}
+}
+
+// Check emits issues for locals blocks declared outside the expected file
+func (r *TerraformLocalsFileRule) Check(runner tflint.Runner) error {
+	config := &terraformLocalsFileRuleConfig{Filename: filenameLocals}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "locals",
+				Body: &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, locals := range body.Blocks {
+		if filename := locals.DefRange.Filename; shouldMove(filename, config.Filename) {
+			if err := runner.EmitIssueWithFix(
+				r,
+				fmt.Sprintf("locals block should be moved from %s to %s", filename, config.Filename),
+				locals.DefRange,
+				moveBlockFix(runner, locals, config.Filename),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
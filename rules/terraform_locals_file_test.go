@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformLocalsFileRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "locals in locals.tf",
+			Content: map[string]string{
+				"locals.tf": `
+locals {
+  name = "x"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "move locals",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  name = "x"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformLocalsFileRule(),
+					Message: "locals block should be moved from main.tf to locals.tf",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 7},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom filename",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  name = "x"
+}
+`,
+				".tflint.hcl": `
+rule "terraform_locals_file" {
+  enabled  = true
+  filename = "main.tf"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "json locals",
+			Content: map[string]string{
+				"main.tf.json": `{"locals": {"name": "x"}}`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewTerraformLocalsFileRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const filenameProviders = "providers.tf"
+
+// terraformProvidersFileRuleConfig is the config structure for the terraform_providers_file rule
+type terraformProvidersFileRuleConfig struct {
+	Filename string `hclext:"filename,optional"`
+}
+
+// TerraformProvidersFileRule checks whether provider configurations live in providers.tf
+type TerraformProvidersFileRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformProvidersFileRule returns a new rule
+func NewTerraformProvidersFileRule() *TerraformProvidersFileRule {
+	return &TerraformProvidersFileRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformProvidersFileRule) Name() string {
+	return "terraform_providers_file"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformProvidersFileRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformProvidersFileRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformProvidersFileRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformProvidersFileRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Ensure that provider configurations live in providers.tf",
+		Tags:        []string{TagStructure},
+		Config: []ConfigOption{
+			{Name: "filename", Description: "Name of the file that holds provider configurations", Default: "providers.tf"},
+		},
+		Example: `
+# main.tf
+provider "aws" {
+  region = "us-east-1"
+}
+`,
+	}
+}
+
+// Check emits issues for provider blocks declared outside the expected file
+func (r *TerraformProvidersFileRule) Check(runner tflint.Runner) error {
+	config := &terraformProvidersFileRuleConfig{Filename: filenameProviders}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "provider",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, provider := range body.Blocks {
+		if filename := provider.DefRange.Filename; shouldMove(filename, config.Filename) {
+			if err := runner.EmitIssueWithFix(
+				r,
+				fmt.Sprintf("provider %q should be moved from %s to %s", provider.Labels[0], filename, config.Filename),
+				provider.DefRange,
+				moveBlockFix(runner, provider, config.Filename),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
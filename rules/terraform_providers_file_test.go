@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformProvidersFileRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "provider in providers.tf",
+			Content: map[string]string{
+				"providers.tf": `
+provider "aws" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "move provider",
+			Content: map[string]string{
+				"main.tf": `
+provider "aws" {
+  region = "us-east-1"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformProvidersFileRule(),
+					Message: `provider "aws" should be moved from main.tf to providers.tf`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 15},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom filename",
+			Content: map[string]string{
+				"providers.tf": `
+provider "aws" {}
+`,
+				".tflint.hcl": `
+rule "terraform_providers_file" {
+  enabled  = true
+  filename = "provider.tf"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformProvidersFileRule(),
+					Message: `provider "aws" should be moved from providers.tf to provider.tf`,
+					Range: hcl.Range{
+						Filename: "providers.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 15},
+					},
+				},
+			},
+		},
+		{
+			Name: "json provider",
+			Content: map[string]string{
+				"main.tf.json": `{"provider": {"aws": {}}}`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewTerraformProvidersFileRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
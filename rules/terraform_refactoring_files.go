@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const (
+	filenameMoved   = "moved.tf"
+	filenameImports = "imports.tf"
+)
+
+// terraformRefactoringFilesRuleConfig is the config structure for the terraform_refactoring_files rule
+type terraformRefactoringFilesRuleConfig struct {
+	MovedFile   string `hclext:"moved_file,optional"`
+	ImportsFile string `hclext:"imports_file,optional"`
+}
+
+// TerraformRefactoringFilesRule checks whether moved and import blocks live in moved.tf and imports.tf
+type TerraformRefactoringFilesRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformRefactoringFilesRule returns a new rule
+func NewTerraformRefactoringFilesRule() *TerraformRefactoringFilesRule {
+	return &TerraformRefactoringFilesRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformRefactoringFilesRule) Name() string {
+	return "terraform_refactoring_files"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformRefactoringFilesRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformRefactoringFilesRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformRefactoringFilesRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformRefactoringFilesRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Ensure that moved and import blocks live in moved.tf and imports.tf",
+		Tags:        []string{TagStructure},
+		Config: []ConfigOption{
+			{Name: "moved_file", Description: "Name of the file that holds moved blocks", Default: "moved.tf"},
+			{Name: "imports_file", Description: "Name of the file that holds import blocks", Default: "imports.tf"},
+		},
+		Example: `
+# main.tf
+moved {
+  from = aws_instance.old
+  to   = aws_instance.new
+}
+`,
+	}
+}
+
+// Check emits issues for moved and import blocks declared outside their expected files
+func (r *TerraformRefactoringFilesRule) Check(runner tflint.Runner) error {
+	config := &terraformRefactoringFilesRuleConfig{
+		MovedFile:   filenameMoved,
+		ImportsFile: filenameImports,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "moved",
+				Body: &hclext.BodySchema{},
+			},
+			{
+				Type: "import",
+				Body: &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	expected := map[string]string{
+		"moved":  config.MovedFile,
+		"import": config.ImportsFile,
+	}
+
+	for _, block := range body.Blocks {
+		if filename := block.DefRange.Filename; shouldMove(filename, expected[block.Type]) {
+			if err := runner.EmitIssueWithFix(
+				r,
+				fmt.Sprintf("%s block should be moved from %s to %s", block.Type, filename, expected[block.Type]),
+				block.DefRange,
+				moveBlockFix(runner, block, expected[block.Type]),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformRefactoringFilesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "blocks in dedicated files",
+			Content: map[string]string{
+				"moved.tf": `
+moved {
+  from = aws_instance.a
+  to   = aws_instance.b
+}
+`,
+				"imports.tf": `
+import {
+  to = aws_instance.b
+  id = "i-123"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "move blocks",
+			Content: map[string]string{
+				"main.tf": `
+moved {
+  from = aws_instance.a
+  to   = aws_instance.b
+}
+import {
+  to = aws_instance.b
+  id = "i-123"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRefactoringFilesRule(),
+					Message: "moved block should be moved from main.tf to moved.tf",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 6},
+					},
+				},
+				{
+					Rule:    NewTerraformRefactoringFilesRule(),
+					Message: "import block should be moved from main.tf to imports.tf",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 7},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom filenames",
+			Content: map[string]string{
+				"refactoring.tf": `
+moved {
+  from = aws_instance.a
+  to   = aws_instance.b
+}
+import {
+  to = aws_instance.b
+  id = "i-123"
+}
+`,
+				".tflint.hcl": `
+rule "terraform_refactoring_files" {
+  enabled      = true
+  moved_file   = "refactoring.tf"
+  imports_file = "refactoring.tf"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "json",
+			Content: map[string]string{
+				"main.tf.json": `{"moved": [{"from": "aws_instance.a", "to": "aws_instance.b"}]}`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewTerraformRefactoringFilesRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
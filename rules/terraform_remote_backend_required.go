@@ -0,0 +1,159 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// terraformRemoteBackendRequiredRuleConfig is the config structure for the terraform_remote_backend_required rule
+type terraformRemoteBackendRequiredRuleConfig struct {
+	AllowedTypes []string `hclext:"allowed_types,optional"`
+}
+
+// TerraformRemoteBackendRequiredRule checks whether root modules store state in a remote backend
+type TerraformRemoteBackendRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformRemoteBackendRequiredRule returns a new rule
+func NewTerraformRemoteBackendRequiredRule() *TerraformRemoteBackendRequiredRule {
+	return &TerraformRemoteBackendRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformRemoteBackendRequiredRule) Name() string {
+	return "terraform_remote_backend_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformRemoteBackendRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformRemoteBackendRequiredRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *TerraformRemoteBackendRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformRemoteBackendRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require root modules to store state in a remote backend",
+		Tags:        []string{TagSecurity},
+		Config: []ConfigOption{
+			{Name: "allowed_types", Description: "Backend types the root module may use", Default: "[]"},
+		},
+		Example: `
+terraform {
+  backend "local" {}
+}
+`,
+	}
+}
+
+// Check emits issues when the root module has no remote backend, or one that is not in allowed_types
+func (r *TerraformRemoteBackendRequiredRule) Check(runner tflint.Runner) error {
+	config := &terraformRemoteBackendRequiredRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "terraform",
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{Type: "backend", LabelNames: []string{"type"}, Body: &hclext.BodySchema{}},
+						{Type: "cloud", Body: &hclext.BodySchema{}},
+					},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool, len(config.AllowedTypes))
+	for _, t := range config.AllowedTypes {
+		allowed[t] = true
+	}
+
+	remote := false
+	for _, terraform := range body.Blocks {
+		for _, backend := range terraform.Body.Blocks {
+			backendType := backend.Type
+			if backend.Type == "backend" {
+				backendType = backend.Labels[0]
+			}
+
+			if backendType == "local" {
+				if err := runner.EmitIssue(
+					r,
+					"local backend is not allowed, configure a remote backend to store state",
+					backend.DefRange,
+				); err != nil {
+					return err
+				}
+				continue
+			}
+			remote = true
+
+			if len(allowed) > 0 && !allowed[backendType] {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("backend type %q is not allowed, use one of: %s", backendType, strings.Join(config.AllowedTypes, ", ")),
+					backend.DefRange,
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if remote {
+		return nil
+	}
+
+	issueRange := hcl.Range{
+		Filename: filepath.Join(dir, filenameVersions),
+		Start:    hcl.InitialPos,
+	}
+	if len(body.Blocks) > 0 {
+		issueRange = body.Blocks[0].DefRange
+	}
+	return runner.EmitIssue(
+		r,
+		"Root module should configure a remote backend or cloud block in the terraform block",
+		issueRange,
+	)
+}
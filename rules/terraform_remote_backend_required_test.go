@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformRemoteBackendRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "s3 backend",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  backend "s3" {}
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "cloud block",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  cloud {}
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "no terraform block",
+			Content: map[string]string{
+				"main.tf": "",
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRemoteBackendRequiredRule(),
+					Message: "Root module should configure a remote backend or cloud block in the terraform block",
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+			},
+		},
+		{
+			Name: "local backend",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  backend "local" {}
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRemoteBackendRequiredRule(),
+					Message: "local backend is not allowed, configure a remote backend to store state",
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 18},
+					},
+				},
+				{
+					Rule:    NewTerraformRemoteBackendRequiredRule(),
+					Message: "Root module should configure a remote backend or cloud block in the terraform block",
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 10},
+					},
+				},
+			},
+		},
+		{
+			Name: "allowed types",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  backend "consul" {}
+}
+`,
+				".tflint.hcl": `
+rule "terraform_remote_backend_required" {
+  enabled       = true
+  allowed_types = ["s3", "gcs"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRemoteBackendRequiredRule(),
+					Message: `backend type "consul" is not allowed, use one of: s3, gcs`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 19},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformRemoteBackendRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// terraformRequiredProvidersConstraintRuleConfig is the config structure for the terraform_required_providers_constraint rule
+type terraformRequiredProvidersConstraintRuleConfig struct {
+	Policy string `hclext:"policy,optional"`
+}
+
+// TerraformRequiredProvidersConstraintRule checks whether provider version constraints follow a constraint policy
+type TerraformRequiredProvidersConstraintRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformRequiredProvidersConstraintRule returns a new rule
+func NewTerraformRequiredProvidersConstraintRule() *TerraformRequiredProvidersConstraintRule {
+	return &TerraformRequiredProvidersConstraintRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformRequiredProvidersConstraintRule) Name() string {
+	return "terraform_required_providers_constraint"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformRequiredProvidersConstraintRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformRequiredProvidersConstraintRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformRequiredProvidersConstraintRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformRequiredProvidersConstraintRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require required_providers version constraints to follow a constraint policy, a ~> range by default",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "policy", Description: "`pessimistic` requires a lower and an upper bound such as ~> 5.0, `minimum` requires a lower bound such as >= 5.0, `exact` requires an exact version, as production root modules may", Default: constraintPolicyPessimistic},
+		},
+		Example: `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = ">= 5.0"
+    }
+  }
+}
+`,
+	}
+}
+
+// Check emits an issue for every required_providers entry without a version constraint, as any version
+// would be installed, or with a constraint that does not follow the policy
+func (r *TerraformRequiredProvidersConstraintRule) Check(runner tflint.Runner) error {
+	config := &terraformRequiredProvidersConstraintRuleConfig{Policy: constraintPolicyPessimistic}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if err := validateConstraintPolicy(config.Policy); err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "terraform",
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "required_providers",
+							Body: &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode},
+						},
+					},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, terraform := range body.Blocks {
+		for _, requiredProviders := range terraform.Body.Blocks {
+			names := make([]string, 0, len(requiredProviders.Body.Attributes))
+			for name := range requiredProviders.Body.Attributes {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				attr := requiredProviders.Body.Attributes[name]
+
+				err := runner.EvaluateExpr(attr.Expr, func(requirement cty.Value) error {
+					// Legacy syntax: a string value is the version constraint
+					constraint := requirement
+					if requirement.Type().IsObjectType() {
+						if !requirement.Type().HasAttribute("version") {
+							return runner.EmitIssue(
+								r,
+								fmt.Sprintf("provider %q has no version constraint, so any version can be installed", name),
+								attr.Range,
+							)
+						}
+						constraint = requirement.GetAttr("version")
+					}
+					if !constraint.IsKnown() || constraint.IsNull() || constraint.Type() != cty.String {
+						return nil
+					}
+
+					violation, err := constraintViolation(constraint.AsString(), config.Policy)
+					if err != nil {
+						return fmt.Errorf("invalid version constraint %q of provider %q: %w", constraint.AsString(), name, err)
+					}
+					if violation == "" {
+						return nil
+					}
+					rng, _ := tagValueRange(attr.Expr, "version")
+					return runner.EmitIssue(
+						r,
+						fmt.Sprintf("version constraint %q of provider %q %s", constraint.AsString(), name, violation),
+						rng,
+					)
+				}, nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformRequiredProvidersConstraintRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "pessimistic",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "open-ended",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = ">= 5.0"
+    }
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRequiredProvidersConstraintRule(),
+					Message: `version constraint ">= 5.0" of provider "aws" is open-ended, use a ~> range instead`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 6, Column: 17},
+						End:      hcl.Pos{Line: 6, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "no version",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_providers {
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRequiredProvidersConstraintRule(),
+					Message: `provider "aws" has no version constraint, so any version can be installed`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 6, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "legacy syntax",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_providers {
+    aws = "5.31.0"
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRequiredProvidersConstraintRule(),
+					Message: `version constraint "5.31.0" of provider "aws" pins an exact version, use a ~> range instead`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 4, Column: 11},
+						End:      hcl.Pos{Line: 4, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name: "exact policy",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "5.31.0"
+    }
+    random = {
+      source  = "hashicorp/random"
+      version = "~> 3.6"
+    }
+  }
+}
+`,
+				".tflint.hcl": `
+rule "terraform_required_providers_constraint" {
+  enabled = true
+  policy  = "exact"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRequiredProvidersConstraintRule(),
+					Message: `version constraint "~> 3.6" of provider "random" should pin an exact version`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 10, Column: 17},
+						End:      hcl.Pos{Line: 10, Column: 25},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformRequiredProvidersConstraintRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// terraformRequiredProvidersCoverageRuleConfig is the config structure for the terraform_required_providers_coverage rule
+type terraformRequiredProvidersCoverageRuleConfig struct {
+	CheckUnused bool `hclext:"check_unused,optional"`
+}
+
+// TerraformRequiredProvidersCoverageRule checks whether required_providers declares every provider in use
+type TerraformRequiredProvidersCoverageRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformRequiredProvidersCoverageRule returns a new rule
+func NewTerraformRequiredProvidersCoverageRule() *TerraformRequiredProvidersCoverageRule {
+	return &TerraformRequiredProvidersCoverageRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformRequiredProvidersCoverageRule) Name() string {
+	return "terraform_required_providers_coverage"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformRequiredProvidersCoverageRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformRequiredProvidersCoverageRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformRequiredProvidersCoverageRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformRequiredProvidersCoverageRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require required_providers to declare every provider in use",
+		Tags:        []string{TagCorrectness},
+		Config: []ConfigOption{
+			{Name: "check_unused", Description: "Also report required_providers entries that are not used", Default: "false"},
+		},
+		Example: `
+terraform {
+  required_providers {}
+}
+
+resource "aws_s3_bucket" "logs" {}
+`,
+	}
+}
+
+// Check emits issues for providers used by resources and data sources but missing from required_providers.
+// With check_unused, required_providers entries that nothing uses are reported too.
+func (r *TerraformRequiredProvidersCoverageRule) Check(runner tflint.Runner) error {
+	config := &terraformRequiredProvidersCoverageRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	resourceSchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "provider"}},
+	}
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "terraform",
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "required_providers",
+							Body: &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode},
+						},
+					},
+				},
+			},
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: resourceSchema},
+			{Type: "data", LabelNames: []string{"type", "name"}, Body: resourceSchema},
+			{Type: "provider", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	declared := hclext.Attributes{}
+	used := map[string]bool{}
+	reported := map[string]bool{}
+	for _, block := range body.Blocks {
+		switch block.Type {
+		case "terraform":
+			for _, requiredProviders := range block.Body.Blocks {
+				for name, attr := range requiredProviders.Body.Attributes {
+					declared[name] = attr
+				}
+			}
+		case "provider":
+			used[block.Labels[0]] = true
+		}
+	}
+
+	for _, block := range body.Blocks {
+		if block.Type != "resource" && block.Type != "data" {
+			continue
+		}
+
+		provider := resourceProvider(block)
+		if provider == "terraform" {
+			// Built-in resources such as terraform_data need no declaration
+			continue
+		}
+		used[provider] = true
+		if _, exists := declared[provider]; exists || reported[provider] {
+			continue
+		}
+		reported[provider] = true
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("provider %q is used by %s.%s but not declared in required_providers", provider, block.Labels[0], block.Labels[1]),
+			block.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	if !config.CheckUnused {
+		return nil
+	}
+
+	names := make([]string, 0, len(declared))
+	for name := range declared {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if used[name] {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("provider %q is declared in required_providers but not used", name),
+			declared[name].Range,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceProvider returns the local name of the provider for a resource or data block,
+// taken from the provider meta-argument or else the resource type prefix
+func resourceProvider(block *hclext.Block) string {
+	if attr, exists := block.Body.Attributes["provider"]; exists {
+		if traversals := attr.Expr.Variables(); len(traversals) > 0 {
+			return traversals[0].RootName()
+		}
+	}
+	return strings.SplitN(block.Labels[0], "_", 2)[0]
+}
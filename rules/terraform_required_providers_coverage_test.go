@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformRequiredProvidersCoverageRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "all providers declared",
+			Content: map[string]string{
+				"main.tf": `
+terraform {
+  required_providers {
+    aws    = { source = "hashicorp/aws" }
+    google = { source = "hashicorp/google" }
+  }
+}
+resource "aws_instance" "web" {}
+data "google_project" "p" {}
+resource "terraform_data" "d" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "undeclared providers",
+			Content: map[string]string{
+				"main.tf": `
+terraform {
+  required_providers {
+    aws = { source = "hashicorp/aws" }
+  }
+}
+resource "aws_instance" "web" {}
+resource "random_id" "a" {}
+resource "random_id" "b" {}
+resource "google_storage_bucket" "b" {
+  provider = google-beta.europe
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRequiredProvidersCoverageRule(),
+					Message: `provider "random" is used by random_id.a but not declared in required_providers`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 1},
+						End:      hcl.Pos{Line: 8, Column: 25},
+					},
+				},
+				{
+					Rule:    NewTerraformRequiredProvidersCoverageRule(),
+					Message: `provider "google-beta" is used by google_storage_bucket.b but not declared in required_providers`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "unused providers",
+			Content: map[string]string{
+				"main.tf": `
+terraform {
+  required_providers {
+    aws    = { source = "hashicorp/aws" }
+    random = { source = "hashicorp/random" }
+    null   = { source = "hashicorp/null" }
+  }
+}
+provider "aws" {}
+resource "null_resource" "n" {}
+`,
+				".tflint.hcl": `
+rule "terraform_required_providers_coverage" {
+  enabled      = true
+  check_unused = true
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRequiredProvidersCoverageRule(),
+					Message: `provider "random" is declared in required_providers but not used`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 5},
+						End:      hcl.Pos{Line: 5, Column: 45},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformRequiredProvidersCoverageRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
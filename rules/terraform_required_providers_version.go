@@ -0,0 +1,142 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// terraformRequiredProvidersVersionRuleConfig is the config structure for the terraform_required_providers_version rule
+type terraformRequiredProvidersVersionRuleConfig struct {
+	RequireSource bool `hclext:"require_source,optional"`
+}
+
+// TerraformRequiredProvidersVersionRule checks whether required_providers entries pin a version constraint
+type TerraformRequiredProvidersVersionRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformRequiredProvidersVersionRule returns a new rule
+func NewTerraformRequiredProvidersVersionRule() *TerraformRequiredProvidersVersionRule {
+	return &TerraformRequiredProvidersVersionRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformRequiredProvidersVersionRule) Name() string {
+	return "terraform_required_providers_version"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformRequiredProvidersVersionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformRequiredProvidersVersionRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformRequiredProvidersVersionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformRequiredProvidersVersionRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require a version constraint for every entry in required_providers",
+		Tags:        []string{TagCorrectness},
+		Config: []ConfigOption{
+			{Name: "require_source", Description: "Also require a source address for every provider", Default: "false"},
+		},
+		Example: `
+terraform {
+  required_providers {
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+`,
+	}
+}
+
+// Check emits issues for required_providers entries without a version, or without a source when require_source is set
+func (r *TerraformRequiredProvidersVersionRule) Check(runner tflint.Runner) error {
+	config := &terraformRequiredProvidersVersionRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "terraform",
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "required_providers",
+							Body: &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode},
+						},
+					},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, terraform := range body.Blocks {
+		for _, requiredProviders := range terraform.Body.Blocks {
+			names := make([]string, 0, len(requiredProviders.Body.Attributes))
+			for name := range requiredProviders.Body.Attributes {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				attr := requiredProviders.Body.Attributes[name]
+
+				err := runner.EvaluateExpr(attr.Expr, func(requirement cty.Value) error {
+					// Legacy syntax: a string value is the version constraint
+					hasVersion := requirement.Type() == cty.String
+					hasSource := false
+					if requirement.Type().IsObjectType() {
+						hasVersion = requirement.Type().HasAttribute("version")
+						hasSource = requirement.Type().HasAttribute("source")
+					}
+
+					if !hasVersion {
+						if err := runner.EmitIssue(
+							r,
+							fmt.Sprintf("provider %q should have a version constraint", name),
+							attr.Range,
+						); err != nil {
+							return err
+						}
+					}
+					if config.RequireSource && !hasSource {
+						if err := runner.EmitIssue(
+							r,
+							fmt.Sprintf("provider %q should have a source address", name),
+							attr.Range,
+						); err != nil {
+							return err
+						}
+					}
+					return nil
+				}, nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformRequiredProvidersVersionRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "pinned providers",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+    random = "~> 3.0"
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing version",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_providers {
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRequiredProvidersVersionRule(),
+					Message: `provider "aws" should have a version constraint`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 6, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "require source",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_providers {
+    random = "~> 3.0"
+  }
+}
+`,
+				".tflint.hcl": `
+rule "terraform_required_providers_version" {
+  enabled        = true
+  require_source = true
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRequiredProvidersVersionRule(),
+					Message: `provider "random" should have a source address`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 4, Column: 22},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformRequiredProvidersVersionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// TerraformRequiredVersionRule checks whether a module declares terraform required_version
+type TerraformRequiredVersionRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformRequiredVersionRule returns a new rule
+func NewTerraformRequiredVersionRule() *TerraformRequiredVersionRule {
+	return &TerraformRequiredVersionRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformRequiredVersionRule) Name() string {
+	return "terraform_required_version"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformRequiredVersionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformRequiredVersionRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformRequiredVersionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformRequiredVersionRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require terraform required_version to be declared",
+		Tags:        []string{TagCorrectness},
+		Example: `
+terraform {
+  required_providers {}
+}
+`,
+	}
+}
+
+// Check emits an issue pointing at versions.tf when no terraform block declares required_version
+func (r *TerraformRequiredVersionRule) Check(runner tflint.Runner) error {
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "terraform",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "required_version"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, terraform := range body.Blocks {
+		if _, exists := terraform.Body.Attributes["required_version"]; exists {
+			return nil
+		}
+	}
+
+	return runner.EmitIssue(
+		r,
+		"terraform \"required_version\" attribute is required, declare it in the terraform block",
+		hcl.Range{
+			Filename: filepath.Join(dir, filenameVersions),
+			Start:    hcl.InitialPos,
+		},
+	)
+}
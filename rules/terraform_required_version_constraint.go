@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// terraformRequiredVersionConstraintRuleConfig is the config structure for the terraform_required_version_constraint rule
+type terraformRequiredVersionConstraintRuleConfig struct {
+	Policy string `hclext:"policy,optional"`
+}
+
+// TerraformRequiredVersionConstraintRule checks whether required_version follows a constraint policy
+type TerraformRequiredVersionConstraintRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformRequiredVersionConstraintRule returns a new rule
+func NewTerraformRequiredVersionConstraintRule() *TerraformRequiredVersionConstraintRule {
+	return &TerraformRequiredVersionConstraintRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformRequiredVersionConstraintRule) Name() string {
+	return "terraform_required_version_constraint"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformRequiredVersionConstraintRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformRequiredVersionConstraintRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformRequiredVersionConstraintRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformRequiredVersionConstraintRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require terraform required_version to follow a constraint policy, a ~> range by default",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "policy", Description: "`pessimistic` requires a lower and an upper bound such as ~> 1.5, `minimum` requires a lower bound such as >= 1.5, `exact` requires an exact version", Default: constraintPolicyPessimistic},
+		},
+		Example: `
+terraform {
+  required_version = ">= 1.0"
+}
+`,
+	}
+}
+
+// Check emits an issue for every required_version that does not follow the policy
+func (r *TerraformRequiredVersionConstraintRule) Check(runner tflint.Runner) error {
+	config := &terraformRequiredVersionConstraintRuleConfig{Policy: constraintPolicyPessimistic}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if err := validateConstraintPolicy(config.Policy); err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "terraform",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "required_version"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, terraform := range body.Blocks {
+		attr, exists := terraform.Body.Attributes["required_version"]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(constraint string) error {
+			violation, err := constraintViolation(constraint, config.Policy)
+			if err != nil {
+				return fmt.Errorf("invalid required_version %q: %w", constraint, err)
+			}
+			if violation == "" {
+				return nil
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("required_version %q %s", constraint, violation),
+				attr.Expr.Range(),
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformRequiredVersionConstraintRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "pessimistic",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_version = "~> 1.5"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "bounded range",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_version = ">= 1.3, < 2.0"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "open-ended",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_version = ">= 1.0"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRequiredVersionConstraintRule(),
+					Message: `required_version ">= 1.0" is open-ended, use a ~> range instead`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 3, Column: 22},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "exact pin",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_version = "1.5.7"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRequiredVersionConstraintRule(),
+					Message: `required_version "1.5.7" pins an exact version, use a ~> range instead`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 3, Column: 22},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "minimum policy",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_version = "< 2.0"
+}
+`,
+				".tflint.hcl": `
+rule "terraform_required_version_constraint" {
+  enabled = true
+  policy  = "minimum"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRequiredVersionConstraintRule(),
+					Message: `required_version "< 2.0" has no lower bound, use a minimum version such as >= instead`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 3, Column: 22},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "exact policy",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_version = "= 1.5.7"
+}
+`,
+				".tflint.hcl": `
+rule "terraform_required_version_constraint" {
+  enabled = true
+  policy  = "exact"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewTerraformRequiredVersionConstraintRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
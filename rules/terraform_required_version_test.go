@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformRequiredVersionRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name:     "empty module",
+			Content:  map[string]string{},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "required_version declared",
+			Content: map[string]string{
+				"main.tf": `
+terraform {
+  required_version = ">= 1.5"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "required_version missing",
+			Content: map[string]string{
+				"main.tf": `
+terraform {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformRequiredVersionRule(),
+					Message: `terraform "required_version" attribute is required, declare it in the terraform block`,
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformRequiredVersionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
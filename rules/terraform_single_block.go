@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// TerraformSingleBlockRule checks whether a module declares a single terraform block
+type TerraformSingleBlockRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformSingleBlockRule returns a new rule
+func NewTerraformSingleBlockRule() *TerraformSingleBlockRule {
+	return &TerraformSingleBlockRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformSingleBlockRule) Name() string {
+	return "terraform_single_block"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformSingleBlockRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformSingleBlockRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformSingleBlockRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformSingleBlockRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow more than one terraform block per module",
+		Tags:        []string{TagStructure},
+		Example: `
+# versions.tf
+terraform {
+  required_version = "~> 1.5"
+}
+
+# backend.tf
+terraform {
+  backend "s3" {}
+}
+`,
+	}
+}
+
+// Check emits an issue for every terraform block after the first, pointing at the first one.
+// The block in versions.tf is the first if there is one, otherwise blocks are ordered by filename and line.
+func (r *TerraformSingleBlockRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: "terraform"}},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+	if len(body.Blocks) < 2 {
+		return nil
+	}
+
+	blocks := append(hclext.Blocks{}, body.Blocks...)
+	sort.SliceStable(blocks, func(i, j int) bool {
+		a, b := blocks[i].DefRange, blocks[j].DefRange
+		aVersions, bVersions := filepath.Base(a.Filename) == filenameVersions, filepath.Base(b.Filename) == filenameVersions
+		if aVersions != bVersions {
+			return aVersions
+		}
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Start.Line < b.Start.Line
+	})
+
+	first := blocks[0].DefRange
+	for _, block := range blocks[1:] {
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("terraform block is already declared at %s:%d, keep the terraform settings in a single block", first.Filename, first.Start.Line),
+			block.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformSingleBlockRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "single block",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_version = "~> 1.5"
+  backend "s3" {}
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "blocks across files",
+			Content: map[string]string{
+				"backend.tf": `
+terraform {
+  backend "s3" {}
+}
+`,
+				"versions.tf": `
+terraform {
+  required_version = "~> 1.5"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformSingleBlockRule(),
+					Message: "terraform block is already declared at versions.tf:2, keep the terraform settings in a single block",
+					Range: hcl.Range{
+						Filename: "backend.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 10},
+					},
+				},
+			},
+		},
+		{
+			Name: "blocks in one file",
+			Content: map[string]string{
+				"main.tf": `
+terraform {
+  required_version = "~> 1.5"
+}
+
+terraform {
+  backend "s3" {}
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformSingleBlockRule(),
+					Message: "terraform block is already declared at main.tf:2, keep the terraform settings in a single block",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 10},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformSingleBlockRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// terraformMinorVersions are the Terraform minor versions since the 0.12 language, in release order
+var terraformMinorVersions = []string{"0.12", "0.13", "0.14", "0.15", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9"}
+
+// supportsTerraformBefore returns whether the required_version constraints declared in the terraform blocks
+// allow any Terraform version older than the given minor version. Modules without a required_version are
+// assumed to target current Terraform.
+func supportsTerraformBefore(runner tflint.Runner, blocks hclext.Blocks, minor string) (bool, error) {
+	constraints := version.Constraints{}
+	for _, block := range blocks {
+		attr, exists := block.Body.Attributes["required_version"]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(v string) error {
+			c, err := version.NewConstraint(v)
+			if err != nil {
+				return fmt.Errorf("invalid required_version %q: %w", v, err)
+			}
+			constraints = append(constraints, c...)
+			return nil
+		}, nil)
+		if err != nil {
+			return false, err
+		}
+	}
+	if len(constraints) == 0 {
+		return false, nil
+	}
+
+	// Probe the lowest and highest patch releases of each older minor version
+	for _, older := range terraformMinorVersions {
+		if older == minor {
+			break
+		}
+		for _, patch := range []string{"0", "99"} {
+			if constraints.Check(version.Must(version.NewVersion(older + "." + patch))) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
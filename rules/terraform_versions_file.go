@@ -0,0 +1,155 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const filenameVersions = "versions.tf"
+
+// TerraformVersionsFileRule checks whether the terraform block lives in versions.tf
+type TerraformVersionsFileRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformVersionsFileRule returns a new rule
+func NewTerraformVersionsFileRule() *TerraformVersionsFileRule {
+	return &TerraformVersionsFileRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformVersionsFileRule) Name() string {
+	return "terraform_versions_file"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformVersionsFileRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformVersionsFileRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformVersionsFileRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformVersionsFileRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Ensure that the terraform block with version constraints lives in versions.tf",
+		Tags:        []string{TagStructure},
+		Example: `
+# main.tf
+terraform {
+  required_version = ">= 1.0"
+}
+`,
+	}
+}
+
+// Check emits issues for a missing versions.tf, incomplete version constraints, and terraform blocks in other files
+func (r *TerraformVersionsFileRule) Check(runner tflint.Runner) error {
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	onlyJSON, err := onlyJSON(runner)
+	if err != nil {
+		return err
+	}
+	if onlyJSON {
+		return nil
+	}
+
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		// This rule does not run on non-Terraform directory.
+		return nil
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "terraform",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "required_version"}},
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "required_providers",
+							Body: &hclext.BodySchema{},
+						},
+					},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	if files[filenameVersions] == nil {
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("Module should include a %s file with the terraform block", filenameVersions),
+			hcl.Range{
+				Filename: filepath.Join(dir, filenameVersions),
+				Start:    hcl.InitialPos,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, block := range body.Blocks {
+		filename := block.DefRange.Filename
+		if shouldMove(filename, filenameVersions) {
+			if err := runner.EmitIssueWithFix(
+				r,
+				fmt.Sprintf("terraform block should be moved from %s to %s", filename, filenameVersions),
+				block.DefRange,
+				moveBlockFix(runner, block, filenameVersions),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, exists := block.Body.Attributes["required_version"]; !exists {
+			if err := runner.EmitIssue(
+				r,
+				"terraform block should declare required_version",
+				block.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+		if len(block.Body.Blocks.OfType("required_providers")) == 0 {
+			if err := runner.EmitIssue(
+				r,
+				"terraform block should declare required_providers",
+				block.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
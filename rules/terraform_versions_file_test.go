@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformVersionsFileRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Fixed    map[string]string
+	}{
+		{
+			Name:     "empty module",
+			Content:  map[string]string{},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "complete versions.tf",
+			Content: map[string]string{
+				"main.tf": "",
+				"versions.tf": `
+terraform {
+  required_version = ">= 1.0"
+  required_providers {}
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing versions.tf",
+			Content: map[string]string{
+				"main.tf": "",
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformVersionsFileRule(),
+					Message: "Module should include a versions.tf file with the terraform block",
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+			},
+		},
+		{
+			Name: "move terraform block",
+			Content: map[string]string{
+				"main.tf": `
+terraform {
+  required_version = ">= 1.0"
+}
+`,
+				"versions.tf": "",
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformVersionsFileRule(),
+					Message: "terraform block should be moved from main.tf to versions.tf",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 10},
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"main.tf": `
+`,
+				"versions.tf": `terraform {
+  required_version = ">= 1.0"
+}
+`,
+			},
+		},
+		{
+			Name: "incomplete terraform block",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformVersionsFileRule(),
+					Message: "terraform block should declare required_version",
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 10},
+					},
+				},
+				{
+					Rule:    NewTerraformVersionsFileRule(),
+					Message: "terraform block should declare required_providers",
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 10},
+					},
+				},
+			},
+		},
+		{
+			Name: "json only",
+			Content: map[string]string{
+				"main.tf.json": `{"terraform": {}}`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewTerraformVersionsFileRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			if tc.Fixed != nil {
+				helper.AssertChanges(t, tc.Fixed, runner.Changes())
+			}
+		})
+	}
+}
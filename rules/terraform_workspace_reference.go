@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// TerraformWorkspaceReferenceRule checks whether expressions reference terraform.workspace
+type TerraformWorkspaceReferenceRule struct {
+	tflint.DefaultRule
+}
+
+// NewTerraformWorkspaceReferenceRule returns a new rule
+func NewTerraformWorkspaceReferenceRule() *TerraformWorkspaceReferenceRule {
+	return &TerraformWorkspaceReferenceRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformWorkspaceReferenceRule) Name() string {
+	return "terraform_workspace_reference"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformWorkspaceReferenceRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformWorkspaceReferenceRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformWorkspaceReferenceRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TerraformWorkspaceReferenceRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow terraform.workspace references in favour of directory-per-environment layouts",
+		Tags:        []string{TagBestPractice},
+		Example: `
+locals {
+  environment = terraform.workspace
+}
+`,
+		References: []string{
+			"https://developer.hashicorp.com/terraform/language/state/workspaces#when-not-to-use-multiple-workspaces",
+		},
+	}
+}
+
+// Check emits issues for every reference to terraform.workspace
+func (r *TerraformWorkspaceReferenceRule) Check(runner tflint.Runner) error {
+	diags := runner.WalkExpressions(tflint.ExprWalkFunc(func(expr hcl.Expression) hcl.Diagnostics {
+		// Only traversal nodes are matched, so enclosing expressions do not report the same reference again
+		if _, ok := expr.(*hclsyntax.ScopeTraversalExpr); !ok {
+			return nil
+		}
+		found := false
+		for _, name := range referencedNames(expr, "terraform") {
+			found = found || name == "workspace"
+		}
+		if !found {
+			return nil
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			"terraform.workspace should not be used, use a separate directory per environment instead",
+			expr.Range(),
+		); err != nil {
+			return hcl.Diagnostics{
+				{
+					Severity: hcl.DiagError,
+					Summary:  "failed to call EmitIssue()",
+					Detail:   err.Error(),
+				},
+			}
+		}
+		return nil
+	}))
+	if diags.HasErrors() {
+		return diags
+	}
+
+	return nil
+}
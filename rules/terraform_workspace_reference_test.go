@@ -0,0 +1,75 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TerraformWorkspaceReferenceRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no workspace reference",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  env = var.environment
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "workspace references",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  env = terraform.workspace
+}
+resource "aws_s3_bucket" "b" {
+  bucket = "app-${terraform.workspace}"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTerraformWorkspaceReferenceRule(),
+					Message: "terraform.workspace should not be used, use a separate directory per environment instead",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 9},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+				{
+					Rule:    NewTerraformWorkspaceReferenceRule(),
+					Message: "terraform.workspace should not be used, use a separate directory per environment instead",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 19},
+						End:      hcl.Pos{Line: 6, Column: 38},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformWorkspaceReferenceRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// testFileSuffix is the suffix of the test files run by terraform test
+const testFileSuffix = ".tftest.hcl"
+
+// moduleTestFiles returns the parsed test files of the module, keyed by path. TFLint does not load test files,
+// so they are read from the module root and its tests directory, where terraform test looks for them.
+// Runners created by the ruleset parse them once and share them between rules.
+func moduleTestFiles(runner tflint.Runner) (map[string]*hcl.File, error) {
+	if r, ok := runner.(*globalRunner); ok {
+		runner = r.Runner
+	}
+	if r, ok := runner.(*cachingRunner); ok {
+		return r.TestFiles()
+	}
+	return parseTestFiles(runner)
+}
+
+// parseTestFiles parses the test files next to the module files
+func parseTestFiles(runner tflint.Runner) (map[string]*hcl.File, error) {
+	dir, files, err := moduleFiles(runner)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		// This does not run on non-Terraform directory.
+		return map[string]*hcl.File{}, nil
+	}
+
+	paths := []string{}
+	for _, d := range []string{dir, filepath.Join(dir, dirnameTests)} {
+		entries, err := os.ReadDir(d)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), testFileSuffix) {
+				paths = append(paths, filepath.Join(d, entry.Name()))
+			}
+		}
+	}
+	sort.Strings(paths)
+
+	parser := hclparse.NewParser()
+	testFiles := make(map[string]*hcl.File, len(paths))
+	for _, path := range paths {
+		file, diags := parser.ParseHCLFile(path)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		testFiles[path] = file
+	}
+	return testFiles, nil
+}
@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// TestRunAssertionsRule checks whether run blocks in test files assert anything
+type TestRunAssertionsRule struct {
+	tflint.DefaultRule
+}
+
+// NewTestRunAssertionsRule returns a new rule
+func NewTestRunAssertionsRule() *TestRunAssertionsRule {
+	return &TestRunAssertionsRule{}
+}
+
+// Name returns the rule name
+func (r *TestRunAssertionsRule) Name() string {
+	return "test_run_assertions"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TestRunAssertionsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TestRunAssertionsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TestRunAssertionsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TestRunAssertionsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require run blocks in .tftest.hcl files to have an assert block or expect_failures",
+		Tags:        []string{TagBestPractice},
+		Example: `
+# tests/defaults.tftest.hcl
+run "defaults" {
+  command = plan
+}
+`,
+	}
+}
+
+// Check emits issues for run blocks in the test files of the module that have neither an assert block
+// nor expect_failures, since such a run passes whenever the plan or apply succeeds
+func (r *TestRunAssertionsRule) Check(runner tflint.Runner) error {
+	files, err := moduleTestFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type != "run" || len(block.Labels) != 1 {
+				continue
+			}
+			if _, exists := block.Body.Attributes["expect_failures"]; exists {
+				continue
+			}
+			asserts := false
+			for _, nested := range block.Body.Blocks {
+				if nested.Type == "assert" {
+					asserts = true
+					break
+				}
+			}
+			if asserts {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("run %q has no assert blocks or expect_failures, so it passes whenever the run succeeds", block.Labels[0]),
+				block.DefRange(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
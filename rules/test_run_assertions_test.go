@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TestRunAssertionsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Files    map[string]string
+		Expected func(dir string) helper.Issues
+	}{
+		{
+			Name:     "no test files",
+			Files:    map[string]string{},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "assertions",
+			Files: map[string]string{
+				"tests/defaults.tftest.hcl": `
+run "defaults" {
+  command = plan
+
+  assert {
+    condition     = output.name == "app"
+    error_message = "Unexpected name."
+  }
+}
+
+run "invalid_name" {
+  command = plan
+
+  variables {
+    name = "App"
+  }
+
+  expect_failures = [var.name]
+}
+`,
+			},
+			Expected: func(dir string) helper.Issues { return helper.Issues{} },
+		},
+		{
+			Name: "run without assertions",
+			Files: map[string]string{
+				"main.tftest.hcl": `
+run "setup" {
+  module {
+    source = "./tests/setup"
+  }
+}
+`,
+				"tests/apply.tftest.hcl": `
+variables {
+  name = "app"
+}
+
+run "apply" {}
+`,
+			},
+			Expected: func(dir string) helper.Issues {
+				return helper.Issues{
+					{
+						Rule:    NewTestRunAssertionsRule(),
+						Message: `run "setup" has no assert blocks or expect_failures, so it passes whenever the run succeeds`,
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "main.tftest.hcl"),
+							Start:    hcl.Pos{Line: 2, Column: 1},
+							End:      hcl.Pos{Line: 2, Column: 12},
+						},
+					},
+					{
+						Rule:    NewTestRunAssertionsRule(),
+						Message: `run "apply" has no assert blocks or expect_failures, so it passes whenever the run succeeds`,
+						Range: hcl.Range{
+							Filename: filepath.Join(dir, "tests", "apply.tftest.hcl"),
+							Start:    hcl.Pos{Line: 6, Column: 1},
+							End:      hcl.Pos{Line: 6, Column: 12},
+						},
+					},
+				}
+			},
+		},
+	}
+
+	rule := NewTestRunAssertionsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.Files)
+
+			runner := helper.TestRunner(t, map[string]string{filepath.Join(dir, "main.tf"): ""})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected(dir), runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+variable "bucket_name" {
+  description = "Name of the S3 bucket"
+  type        = string
+}
+
+variable "tags" {
+  description = "Tags applied to every resource"
+  type        = map(string)
+}
+
+variable "force_destroy" {
+  type    = bool
+  default = false
+}
+
+output "bucket_arn" {
+  value = "arn:aws:s3:::${var.bucket_name}"
+}
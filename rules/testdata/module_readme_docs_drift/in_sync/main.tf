@@ -0,0 +1,15 @@
+variable "bucket_name" {
+  description = "Name of the bucket, e.g. my_bucket"
+  type        = string
+}
+
+variable "tags" {
+  description = "Tags | labels applied to every resource"
+  type        = map(string)
+  default     = {}
+}
+
+output "bucket_arn" {
+  description = "ARN of the bucket"
+  value       = "arn:aws:s3:::${var.bucket_name}"
+}
@@ -0,0 +1,13 @@
+variable "name" {
+  type        = string
+  description = "Name of the bucket."
+}
+
+resource "aws_s3_bucket" "this" {
+  bucket = var.name
+}
+
+output "arn" {
+  description = "ARN of the bucket."
+  value       = aws_s3_bucket.this.arn
+}
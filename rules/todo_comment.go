@@ -0,0 +1,140 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// todoCommentRuleConfig is the config structure for the todo_comment rule
+type todoCommentRuleConfig struct {
+	Markers          []string `hclext:"markers,optional"`
+	ReferencePattern string   `hclext:"reference_pattern,optional"`
+}
+
+// TodoCommentRule checks whether comments contain markers such as TODO or FIXME
+type TodoCommentRule struct {
+	tflint.DefaultRule
+}
+
+// NewTodoCommentRule returns a new rule
+func NewTodoCommentRule() *TodoCommentRule {
+	return &TodoCommentRule{}
+}
+
+// Name returns the rule name
+func (r *TodoCommentRule) Name() string {
+	return "todo_comment"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TodoCommentRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TodoCommentRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *TodoCommentRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TodoCommentRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Report TODO, FIXME and HACK comments, optionally only those without a ticket reference",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "markers", Description: "Words that mark a comment as unfinished work", Default: `["TODO", "FIXME", "HACK"]`},
+			{Name: "reference_pattern", Description: "Regular expression of a ticket ID. When set, markers followed by a matching ID in parentheses such as TODO(JIRA-123) are accepted", Default: ""},
+		},
+		Example: `
+# TODO: restrict the CIDR blocks
+resource "aws_security_group" "this" {}
+`,
+	}
+}
+
+// Check emits an issue for every marker found in a comment, unless it references a ticket matching reference_pattern
+func (r *TodoCommentRule) Check(runner tflint.Runner) error {
+	config := &todoCommentRuleConfig{Markers: []string{"TODO", "FIXME", "HACK"}}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if len(config.Markers) == 0 {
+		return nil
+	}
+
+	quoted := make([]string, len(config.Markers))
+	for i, marker := range config.Markers {
+		quoted[i] = regexp.QuoteMeta(marker)
+	}
+	markers := regexp.MustCompile(`\b(` + strings.Join(quoted, "|") + `)\b(?:\(([^)]*)\))?`)
+
+	var reference *regexp.Regexp
+	if config.ReferencePattern != "" {
+		pattern, err := regexp.Compile("^(?:" + config.ReferencePattern + ")$")
+		if err != nil {
+			return fmt.Errorf("invalid reference_pattern %q: %w", config.ReferencePattern, err)
+		}
+		reference = pattern
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if filepath.Ext(name) == ".json" {
+			// JSON files have no comments
+			continue
+		}
+
+		tokens, diags := hclsyntax.LexConfig(files[name].Bytes, name, hcl.InitialPos)
+		if diags.HasErrors() {
+			return diags
+		}
+
+		for _, token := range tokens {
+			if token.Type != hclsyntax.TokenComment {
+				continue
+			}
+
+			for _, match := range markers.FindAllSubmatch(token.Bytes, -1) {
+				marker := string(match[1])
+				var message string
+				switch {
+				case reference == nil:
+					message = fmt.Sprintf("%s comment found, resolve it or track it in a ticket", marker)
+				case !reference.Match(match[2]):
+					message = fmt.Sprintf("%s comment should reference a ticket, e.g. %s(<ticket>) where the ticket matches %s", marker, marker, config.ReferencePattern)
+				default:
+					continue
+				}
+
+				if err := runner.EmitIssue(r, message, commentRange(token)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TodoCommentRule(t *testing.T) {
+	content := `
+# TODO(OPS-123): restrict the CIDR blocks
+resource "aws_security_group" "this" {
+  name = "todo" // FIXME
+  /* HACK: works around a provider bug */
+}
+# This is a TODOLIST of nothing
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no markers",
+			Content: map[string]string{
+				"main.tf": `
+# The security group
+resource "aws_security_group" "this" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "markers",
+			Content: map[string]string{
+				"main.tf": content,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTodoCommentRule(),
+					Message: "TODO comment found, resolve it or track it in a ticket",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+				{
+					Rule:    NewTodoCommentRule(),
+					Message: "FIXME comment found, resolve it or track it in a ticket",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 17},
+						End:      hcl.Pos{Line: 4, Column: 25},
+					},
+				},
+				{
+					Rule:    NewTodoCommentRule(),
+					Message: "HACK comment found, resolve it or track it in a ticket",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "reference pattern",
+			Content: map[string]string{
+				"main.tf": content,
+				".tflint.hcl": `
+rule "todo_comment" {
+  enabled           = true
+  markers           = ["TODO", "FIXME"]
+  reference_pattern = "[A-Z]+-\\d+"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTodoCommentRule(),
+					Message: `FIXME comment should reference a ticket, e.g. FIXME(<ticket>) where the ticket matches [A-Z]+-\d+`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 17},
+						End:      hcl.Pos{Line: 4, Column: 25},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTodoCommentRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
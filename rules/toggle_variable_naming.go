@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const defaultTogglePattern = `^(create|enabled?)(_|$)`
+
+// toggleVariableNamingRuleConfig is the config structure for the toggle_variable_naming rule
+type toggleVariableNamingRuleConfig struct {
+	Pattern string `hclext:"pattern,optional"`
+}
+
+// ToggleVariableNamingRule checks whether variables toggling the creation of a block match a naming convention
+type ToggleVariableNamingRule struct {
+	tflint.DefaultRule
+}
+
+// NewToggleVariableNamingRule returns a new rule
+func NewToggleVariableNamingRule() *ToggleVariableNamingRule {
+	return &ToggleVariableNamingRule{}
+}
+
+// Name returns the rule name
+func (r *ToggleVariableNamingRule) Name() string {
+	return "toggle_variable_naming"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ToggleVariableNamingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *ToggleVariableNamingRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *ToggleVariableNamingRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *ToggleVariableNamingRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require variables used as `count = var.x ? 1 : 0` toggles to match a naming convention",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "pattern", Description: "Regular expression toggle variable names must match", Default: defaultTogglePattern},
+		},
+		Example: `
+resource "aws_instance" "web" {
+  count = var.web ? 1 : 0
+}
+`,
+	}
+}
+
+// Check emits issues for resources, data sources and module calls whose count toggles on a variable,
+// possibly negated, whose name does not match the pattern.
+func (r *ToggleVariableNamingRule) Check(runner tflint.Runner) error {
+	config := &toggleVariableNamingRuleConfig{Pattern: defaultTogglePattern}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	pattern, err := regexp.Compile(config.Pattern)
+	if err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", config.Pattern, err)
+	}
+
+	blockSchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "count"}},
+	}
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: blockSchema},
+			{Type: "data", LabelNames: []string{"type", "name"}, Body: blockSchema},
+			{Type: "module", LabelNames: []string{"name"}, Body: blockSchema},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, block := range body.Blocks {
+		count, exists := block.Body.Attributes["count"]
+		if !exists {
+			continue
+		}
+		expr, ok := count.Expr.(hclsyntax.Expression)
+		if !ok || !isToggle(expr) {
+			continue
+		}
+
+		condition := expr.(*hclsyntax.ConditionalExpr).Condition
+		if not, ok := condition.(*hclsyntax.UnaryOpExpr); ok && not.Op == hclsyntax.OpLogicalNot {
+			condition = not.Val
+		}
+		name, ok := passthroughVariable(condition)
+		if !ok || pattern.MatchString(name) {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("toggle variable %q does not match %s", name, pattern),
+			condition.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_ToggleVariableNamingRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "matching names",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  count = var.create ? 1 : 0
+}
+module "logs" {
+  count = var.enable_logs ? 1 : 0
+}
+data "aws_ami" "web" {
+  count = !var.enabled ? 0 : 1
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "not a toggle",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  count = var.instances
+}
+resource "aws_eip" "web" {
+  count = var.web && var.public ? 1 : 0
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "ambiguous name",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  count = var.web ? 1 : 0
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewToggleVariableNamingRule(),
+					Message: `toggle variable "web" does not match ^(create|enabled?)(_|$)`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "negated toggle",
+			Content: map[string]string{
+				"main.tf": `
+module "logs" {
+  count = !var.disable_logs ? 1 : 0
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewToggleVariableNamingRule(),
+					Message: `toggle variable "disable_logs" does not match ^(create|enabled?)(_|$)`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 12},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom pattern",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  count = var.create_web ? 1 : 0
+}
+`,
+				".tflint.hcl": `
+rule "toggle_variable_naming" {
+  enabled = true
+  pattern = "^enable_"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewToggleVariableNamingRule(),
+					Message: `toggle variable "create_web" does not match ^enable_`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 25},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewToggleVariableNamingRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
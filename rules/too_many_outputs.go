@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// tooManyOutputsRuleConfig is the config structure for the too_many_outputs rule
+type tooManyOutputsRuleConfig struct {
+	MaxOutputs int `hclext:"max_outputs,optional"`
+}
+
+// TooManyOutputsRule checks whether a module declares more outputs than a threshold
+type TooManyOutputsRule struct {
+	tflint.DefaultRule
+}
+
+// NewTooManyOutputsRule returns a new rule
+func NewTooManyOutputsRule() *TooManyOutputsRule {
+	return &TooManyOutputsRule{}
+}
+
+// Name returns the rule name
+func (r *TooManyOutputsRule) Name() string {
+	return "too_many_outputs"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TooManyOutputsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TooManyOutputsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TooManyOutputsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TooManyOutputsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Limit the number of outputs a module exposes",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "max_outputs", Description: "Maximum number of outputs per module, 0 disables the check", Default: "30"},
+		},
+		Example: `
+# A module exposing more than 30 outputs
+`,
+	}
+}
+
+// Check emits a single issue pointing at outputs.tf when the module exposes more than max_outputs outputs
+func (r *TooManyOutputsRule) Check(runner tflint.Runner) error {
+	config := &tooManyOutputsRuleConfig{MaxOutputs: 30}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if config.MaxOutputs <= 0 {
+		return nil
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "output",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	count := len(body.Blocks)
+	if count <= config.MaxOutputs {
+		return nil
+	}
+
+	dir, _, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf("Module exposes %d outputs (max %d), group related values into object outputs or split the module", count, config.MaxOutputs),
+		hcl.Range{
+			Filename: filepath.Join(dir, filenameOutputs),
+			Start:    hcl.InitialPos,
+		},
+	)
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TooManyOutputsRule(t *testing.T) {
+	config := `
+rule "too_many_outputs" {
+  enabled     = true
+  max_outputs = 1
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "within the limit",
+			Content: map[string]string{
+				"outputs.tf": `
+output "bucket" {
+  value = {
+    arn  = aws_s3_bucket.this.arn
+    name = aws_s3_bucket.this.bucket
+  }
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "over the limit",
+			Content: map[string]string{
+				"outputs.tf": `
+output "bucket_arn" {
+  value = aws_s3_bucket.this.arn
+}
+output "bucket_name" {
+  value = aws_s3_bucket.this.bucket
+}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTooManyOutputsRule(),
+					Message: "Module exposes 2 outputs (max 1), group related values into object outputs or split the module",
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+			},
+		},
+		{
+			Name: "default limit",
+			Content: map[string]string{
+				"outputs.tf": `
+output "bucket_arn" {
+  value = aws_s3_bucket.this.arn
+}
+output "bucket_name" {
+  value = aws_s3_bucket.this.bucket
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewTooManyOutputsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// tooManyVariablesRuleConfig is the config structure for the too_many_variables rule
+type tooManyVariablesRuleConfig struct {
+	MaxVariables int `hclext:"max_variables,optional"`
+}
+
+// TooManyVariablesRule checks whether a module declares more variables than a threshold
+type TooManyVariablesRule struct {
+	tflint.DefaultRule
+}
+
+// NewTooManyVariablesRule returns a new rule
+func NewTooManyVariablesRule() *TooManyVariablesRule {
+	return &TooManyVariablesRule{}
+}
+
+// Name returns the rule name
+func (r *TooManyVariablesRule) Name() string {
+	return "too_many_variables"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TooManyVariablesRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TooManyVariablesRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TooManyVariablesRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *TooManyVariablesRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Limit the number of variables a module declares",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "max_variables", Description: "Maximum number of variables per module, 0 disables the check", Default: "30"},
+		},
+		Example: `
+# A module declaring more than 30 variables
+`,
+	}
+}
+
+// Check emits a single issue pointing at variables.tf when the module declares more than max_variables variables
+func (r *TooManyVariablesRule) Check(runner tflint.Runner) error {
+	config := &tooManyVariablesRuleConfig{MaxVariables: 30}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	if config.MaxVariables <= 0 {
+		return nil
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	count := len(body.Blocks)
+	if count <= config.MaxVariables {
+		return nil
+	}
+
+	dir, _, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf("Module declares %d variables (max %d), group related inputs into object variables or split the module", count, config.MaxVariables),
+		hcl.Range{
+			Filename: filepath.Join(dir, filenameVariables),
+			Start:    hcl.InitialPos,
+		},
+	)
+}
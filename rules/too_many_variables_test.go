@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_TooManyVariablesRule(t *testing.T) {
+	config := `
+rule "too_many_variables" {
+  enabled       = true
+  max_variables = 2
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "within the limit",
+			Content: map[string]string{
+				"variables.tf": `
+variable "name" {}
+variable "region" {}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "over the limit",
+			Content: map[string]string{
+				"variables.tf": `
+variable "name" {}
+variable "region" {}
+`,
+				"main.tf": `
+variable "zone" {}
+`,
+				".tflint.hcl": config,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewTooManyVariablesRule(),
+					Message: "Module declares 3 variables (max 2), group related inputs into object variables or split the module",
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.InitialPos,
+					},
+				},
+			},
+		},
+		{
+			Name: "disabled threshold",
+			Content: map[string]string{
+				"variables.tf": `
+variable "name" {}
+variable "region" {}
+variable "zone" {}
+`,
+				".tflint.hcl": `
+rule "too_many_variables" {
+  enabled       = true
+  max_variables = 0
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewTooManyVariablesRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
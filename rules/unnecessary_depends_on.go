@@ -0,0 +1,156 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/internal/refs"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// UnnecessaryDependsOnRule checks whether depends_on lists dependencies that references already imply
+type UnnecessaryDependsOnRule struct {
+	tflint.DefaultRule
+}
+
+// NewUnnecessaryDependsOnRule returns a new rule
+func NewUnnecessaryDependsOnRule() *UnnecessaryDependsOnRule {
+	return &UnnecessaryDependsOnRule{}
+}
+
+// Name returns the rule name
+func (r *UnnecessaryDependsOnRule) Name() string {
+	return "unnecessary_depends_on"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *UnnecessaryDependsOnRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *UnnecessaryDependsOnRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *UnnecessaryDependsOnRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *UnnecessaryDependsOnRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow depends_on entries that references already imply",
+		Tags:        []string{TagCorrectness},
+		Example: `
+resource "aws_eip" "web" {
+  instance   = aws_instance.web.id
+  depends_on = [aws_instance.web]
+}
+`,
+	}
+}
+
+// Check emits issues for depends_on entries in resource, data and module blocks that the block
+// already references elsewhere, since Terraform infers those dependencies. Entries naming a module are never reported.
+func (r *UnnecessaryDependsOnRule) Check(runner tflint.Runner) error {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, ok := files[name].Body.(*hclsyntax.Body)
+		if !ok {
+			// JSON files are likely generated
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" && block.Type != "data" && block.Type != "module" {
+				continue
+			}
+			if err := r.checkBlock(runner, block); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *UnnecessaryDependsOnRule) checkBlock(runner tflint.Runner, block *hclsyntax.Block) error {
+	dependsOn, exists := block.Body.Attributes["depends_on"]
+	if !exists {
+		return nil
+	}
+	entries, ok := dependsOn.Expr.(*hclsyntax.TupleConsExpr)
+	if !ok {
+		return nil
+	}
+
+	implied := map[string]bool{}
+	for _, ref := range bodyReferences(block.Body, "depends_on") {
+		implied[ref.Addr()] = true
+	}
+
+	label := fmt.Sprintf(`%s "%s"`, block.Type, strings.Join(block.Labels, `" "`))
+
+	for _, entry := range entries.Exprs {
+		traversal, ok := entry.(*hclsyntax.ScopeTraversalExpr)
+		if !ok {
+			continue
+		}
+		addr := refs.Reference{Traversal: traversal.Traversal, Range: traversal.SrcRange}.Addr()
+		if addr == "" || !implied[addr] {
+			continue
+		}
+		if strings.HasPrefix(addr, "module.") {
+			// depends_on a module waits for every resource in it, while references only wait for the outputs they use
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("depends_on %s is unnecessary, %s already references it", addr, label),
+			entry.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bodyReferences returns the traversals in a native syntax body and its nested blocks,
+// skipping the named top-level attribute
+func bodyReferences(body *hclsyntax.Body, skip string) []refs.Reference {
+	found := []refs.Reference{}
+	collect := func(node hclsyntax.Node) hcl.Diagnostics {
+		if traversal, ok := node.(*hclsyntax.ScopeTraversalExpr); ok {
+			found = append(found, refs.Reference{Traversal: traversal.Traversal, Range: traversal.SrcRange})
+		}
+		return nil
+	}
+
+	for _, attr := range body.Attributes {
+		if attr.Name != skip {
+			hclsyntax.VisitAll(attr.Expr, collect)
+		}
+	}
+	for _, nested := range body.Blocks {
+		hclsyntax.VisitAll(nested.Body, collect)
+	}
+	return found
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_UnnecessaryDependsOnRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "necessary depends_on",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  ami        = "ami-123"
+  depends_on = [aws_iam_role_policy.web]
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "implied dependencies",
+			Content: map[string]string{
+				"main.tf": `
+resource "aws_instance" "web" {
+  ami       = data.aws_ami.ubuntu.id
+  subnet_id = module.vpc.subnet_ids[0]
+
+  ebs_block_device {
+    kms_key_id = aws_kms_key.ebs.arn
+  }
+
+  depends_on = [
+    data.aws_ami.ubuntu,
+    module.vpc,
+    aws_kms_key.ebs,
+    aws_iam_role_policy.web,
+  ]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewUnnecessaryDependsOnRule(),
+					Message: `depends_on data.aws_ami.ubuntu is unnecessary, resource "aws_instance" "web" already references it`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 11, Column: 5},
+						End:      hcl.Pos{Line: 11, Column: 24},
+					},
+				},
+				{
+					Rule:    NewUnnecessaryDependsOnRule(),
+					Message: `depends_on aws_kms_key.ebs is unnecessary, resource "aws_instance" "web" already references it`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 13, Column: 5},
+						End:      hcl.Pos{Line: 13, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			// depends_on a module waits for all of its resources, not just the referenced outputs
+			Name: "module block",
+			Content: map[string]string{
+				"main.tf": `
+module "app" {
+  source     = "./app"
+  vpc_id     = module.vpc.id
+  kms_key    = aws_kms_key.app.arn
+  depends_on = [module.vpc, aws_kms_key.app]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewUnnecessaryDependsOnRule(),
+					Message: `depends_on aws_kms_key.app is unnecessary, module "app" already references it`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 29},
+						End:      hcl.Pos{Line: 6, Column: 44},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewUnnecessaryDependsOnRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
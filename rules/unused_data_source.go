@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// UnusedDataSourceRule checks whether declared data sources are referenced
+type UnusedDataSourceRule struct {
+	tflint.DefaultRule
+}
+
+// NewUnusedDataSourceRule returns a new rule
+func NewUnusedDataSourceRule() *UnusedDataSourceRule {
+	return &UnusedDataSourceRule{}
+}
+
+// Name returns the rule name
+func (r *UnusedDataSourceRule) Name() string {
+	return "unused_data_source"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *UnusedDataSourceRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *UnusedDataSourceRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *UnusedDataSourceRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *UnusedDataSourceRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow data sources that are declared but never referenced",
+		Tags:        []string{TagCorrectness},
+		Example: `
+data "aws_caller_identity" "current" {}
+`,
+	}
+}
+
+// Check emits issues for data sources that are never referenced. They are still read on every plan.
+func (r *UnusedDataSourceRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "data",
+				LabelNames: []string{"type", "name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	graph, err := moduleReferences(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, data := range body.Blocks {
+		if graph.Referenced(fmt.Sprintf("data.%s.%s", data.Labels[0], data.Labels[1])) {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf(`data "%s" "%s" is declared but not used`, data.Labels[0], data.Labels[1]),
+			data.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
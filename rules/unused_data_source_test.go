@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_UnusedDataSourceRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "used data sources",
+			Content: map[string]string{
+				"main.tf": `
+data "aws_ami" "ubuntu" {}
+data "aws_caller_identity" "current" {}
+resource "aws_instance" "web" {
+  ami = data.aws_ami.ubuntu.id
+}
+locals {
+  account_id = data.aws_caller_identity.current.account_id
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unused data source",
+			Content: map[string]string{
+				"main.tf": `
+data "aws_ami" "ubuntu" {}
+data "aws_ami" "debian" {}
+output "ami" {
+  value = data.aws_ami.debian.id
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewUnusedDataSourceRule(),
+					Message: `data "aws_ami" "ubuntu" is declared but not used`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 24},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewUnusedDataSourceRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// unusedLocalRuleConfig is the config structure for the unused_local rule
+type unusedLocalRuleConfig struct {
+	IgnorePattern string `hclext:"ignore_pattern,optional"`
+}
+
+// UnusedLocalRule checks whether declared locals are referenced
+type UnusedLocalRule struct {
+	tflint.DefaultRule
+}
+
+// NewUnusedLocalRule returns a new rule
+func NewUnusedLocalRule() *UnusedLocalRule {
+	return &UnusedLocalRule{}
+}
+
+// Name returns the rule name
+func (r *UnusedLocalRule) Name() string {
+	return "unused_local"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *UnusedLocalRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *UnusedLocalRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *UnusedLocalRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *UnusedLocalRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow locals that are declared but never referenced",
+		Tags:        []string{TagCorrectness},
+		Config: []ConfigOption{
+			{Name: "ignore_pattern", Description: "Regular expression of local names that are never reported", Default: ""},
+		},
+		Example: `
+locals {
+  name = "app"
+}
+`,
+	}
+}
+
+// Check emits issues for locals that are never referenced, skipping names that match ignore_pattern
+func (r *UnusedLocalRule) Check(runner tflint.Runner) error {
+	config := &unusedLocalRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	var ignore *regexp.Regexp
+	if config.IgnorePattern != "" {
+		pattern, err := regexp.Compile(config.IgnorePattern)
+		if err != nil {
+			return fmt.Errorf("invalid ignore pattern %q: %w", config.IgnorePattern, err)
+		}
+		ignore = pattern
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "locals",
+				Body: &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	graph, err := moduleReferences(runner)
+	if err != nil {
+		return err
+	}
+
+	locals := []*hclext.Attribute{}
+	for _, block := range body.Blocks {
+		for _, attr := range block.Body.Attributes {
+			locals = append(locals, attr)
+		}
+	}
+	sort.Slice(locals, func(i, j int) bool {
+		if locals[i].Range.Filename != locals[j].Range.Filename {
+			return locals[i].Range.Filename < locals[j].Range.Filename
+		}
+		return locals[i].Range.Start.Byte < locals[j].Range.Start.Byte
+	})
+
+	for _, local := range locals {
+		if graph.Referenced("local."+local.Name) || (ignore != nil && ignore.MatchString(local.Name)) {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("local.%s is declared but not used", local.Name),
+			local.Range,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
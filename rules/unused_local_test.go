@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_UnusedLocalRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "used locals",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  prefix = "app"
+  name   = "${local.prefix}-web"
+}
+output "name" {
+  value = local.name
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unused locals",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  prefix = "app"
+  suffix = "web"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewUnusedLocalRule(),
+					Message: "local.prefix is declared but not used",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 17},
+					},
+				},
+				{
+					Rule:    NewUnusedLocalRule(),
+					Message: "local.suffix is declared but not used",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 17},
+					},
+				},
+			},
+		},
+		{
+			Name: "ignore pattern",
+			Content: map[string]string{
+				"main.tf": `
+locals {
+  _scratch = "x"
+}
+`,
+				".tflint.hcl": `
+rule "unused_local" {
+  enabled        = true
+  ignore_pattern = "^_"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewUnusedLocalRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jforde/tflint-ruleset-hackathon/internal/refs"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// UnusedVariableRule checks whether declared variables are referenced
+type UnusedVariableRule struct {
+	tflint.DefaultRule
+}
+
+// NewUnusedVariableRule returns a new rule
+func NewUnusedVariableRule() *UnusedVariableRule {
+	return &UnusedVariableRule{}
+}
+
+// Name returns the rule name
+func (r *UnusedVariableRule) Name() string {
+	return "unused_variable"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *UnusedVariableRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *UnusedVariableRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *UnusedVariableRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *UnusedVariableRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow variables that are declared but never referenced",
+		Tags:        []string{TagCorrectness},
+		Example: `
+variable "region" {}
+`,
+	}
+}
+
+// Check emits issues for variables that are never referenced.
+// References from a variable's own validation blocks do not count as uses.
+func (r *UnusedVariableRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "validation",
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{{Name: "condition"}, {Name: "error_message"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	graph, err := moduleReferences(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		key := "var." + variable.Labels[0]
+
+		own := []hcl.Range{}
+		for _, validation := range variable.Body.Blocks {
+			for _, attr := range validation.Body.Attributes {
+				own = append(own, attr.Range)
+			}
+		}
+
+		used := false
+		for _, ref := range graph.To(key) {
+			if withinAny(ref, own) {
+				continue
+			}
+			used = true
+			break
+		}
+		if used {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("variable %q is declared but not used", variable.Labels[0]),
+			variable.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withinAny returns whether the reference lies inside any of the ranges
+func withinAny(ref refs.Reference, ranges []hcl.Range) bool {
+	for _, rng := range ranges {
+		if ref.Within(rng) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_UnusedVariableRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "used variables",
+			Content: map[string]string{
+				"variables.tf": `
+variable "name" {}
+variable "tags" {}
+variable "ports" {}
+`,
+				"main.tf": `
+resource "aws_instance" "web" {
+  tags = merge(var.tags, { Name = "${var.name}-web" })
+
+  dynamic "ebs_block_device" {
+    for_each = var.ports[0]
+    content {}
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "json references",
+			Content: map[string]string{
+				"variables.tf": `
+variable "name" {}
+`,
+				"main.tf.json": `{"output": {"name": {"value": "${var.name}"}}}`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unused variables",
+			Content: map[string]string{
+				"variables.tf": `
+variable "unused" {}
+variable "validated" {
+  validation {
+    condition     = length(var.validated) > 0
+    error_message = "Must not be empty."
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewUnusedVariableRule(),
+					Message: `variable "unused" is declared but not used`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+				{
+					Rule:    NewUnusedVariableRule(),
+					Message: `variable "validated" is declared but not used`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 21},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewUnusedVariableRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
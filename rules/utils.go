@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// moduleFiles returns the module directory and its files keyed by base name
+func moduleFiles(runner tflint.Runner) (string, map[string]*hcl.File, error) {
+	f, err := runner.GetFiles()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var dir string
+	files := make(map[string]*hcl.File, len(f))
+	for name, file := range f {
+		dir = filepath.Dir(name)
+		files[filepath.Base(name)] = file
+	}
+
+	return dir, files, nil
+}
+
+// anyFileExists returns whether any of the names exists in dir.
+// Non-Terraform files such as LICENSE are not served by the runner, so this looks at the filesystem.
+func anyFileExists(dir string, names []string) bool {
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// onlyJSON returns whether the module consists solely of JSON files
+func onlyJSON(runner tflint.Runner) (bool, error) {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return false, err
+	}
+
+	if len(files) == 0 {
+		return false, nil
+	}
+
+	for filename := range files {
+		if filepath.Ext(filename) != ".json" {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// shouldMove returns whether a block defined in path belongs in the expected file instead
+func shouldMove(path string, expected string) bool {
+	// json files are likely generated and conventional filenames do not apply
+	if filepath.Ext(path) == ".json" {
+		return false
+	}
+
+	return filepath.Base(path) != expected
+}
+
+// toSeverity converts a severity name from the rule config into a tflint.Severity
+func toSeverity(name string) (tflint.Severity, error) {
+	switch strings.ToUpper(name) {
+	case "ERROR":
+		return tflint.ERROR, nil
+	case "WARNING":
+		return tflint.WARNING, nil
+	case "NOTICE":
+		return tflint.NOTICE, nil
+	default:
+		return tflint.ERROR, fmt.Errorf("%q is an invalid severity. Valid values are ERROR, WARNING, and NOTICE", name)
+	}
+}
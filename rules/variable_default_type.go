@@ -0,0 +1,140 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+// VariableDefaultTypeRule checks whether variable defaults can be converted to the declared type
+type VariableDefaultTypeRule struct {
+	tflint.DefaultRule
+}
+
+// NewVariableDefaultTypeRule returns a new rule
+func NewVariableDefaultTypeRule() *VariableDefaultTypeRule {
+	return &VariableDefaultTypeRule{}
+}
+
+// Name returns the rule name
+func (r *VariableDefaultTypeRule) Name() string {
+	return "variable_default_type"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VariableDefaultTypeRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *VariableDefaultTypeRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *VariableDefaultTypeRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VariableDefaultTypeRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require variable defaults to be convertible to the declared type",
+		Tags:        []string{TagCorrectness},
+		Example: `
+variable "instance_count" {
+  type    = number
+  default = "ten"
+}
+`,
+	}
+}
+
+// Check emits issues for variables whose default cannot be converted to the type constraint, after applying
+// the defaults of optional object attributes the way Terraform does. Null defaults are always accepted.
+func (r *VariableDefaultTypeRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "type"}, {Name: "default"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		typeAttr, exists := variable.Body.Attributes["type"]
+		if !exists {
+			continue
+		}
+		defaultAttr, exists := variable.Body.Attributes["default"]
+		if !exists {
+			continue
+		}
+
+		ty, defaults, diags := typeexpr.TypeConstraintWithDefaults(typeAttr.Expr)
+		if diags.HasErrors() {
+			// Invalid type constraints are reported by Terraform itself
+			continue
+		}
+		val, diags := defaultAttr.Expr.Value(nil)
+		if diags.HasErrors() || val.IsNull() {
+			continue
+		}
+
+		if defaults != nil {
+			val = defaults.Apply(val)
+		}
+		if _, err := convert.Convert(val, ty); err != nil {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("default of variable %q is not compatible with type %s: %s", variable.Labels[0], typeexpr.TypeString(ty), formatConversionError(err)),
+				defaultAttr.Range,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatConversionError returns the conversion error prefixed with the path to the invalid value,
+// e.g. `attribute "size": a number is required`
+func formatConversionError(err error) string {
+	var pathErr cty.PathError
+	if !errors.As(err, &pathErr) || len(pathErr.Path) == 0 {
+		return err.Error()
+	}
+
+	steps := []string{}
+	for _, step := range pathErr.Path {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			steps = append(steps, fmt.Sprintf("attribute %q", s.Name))
+		case cty.IndexStep:
+			switch {
+			case !s.Key.IsKnown() || s.Key.IsNull():
+				steps = append(steps, "element")
+			case s.Key.Type() == cty.String:
+				steps = append(steps, fmt.Sprintf("element %q", s.Key.AsString()))
+			case s.Key.Type() == cty.Number:
+				steps = append(steps, fmt.Sprintf("element %s", s.Key.AsBigFloat().Text('f', -1)))
+			}
+		}
+	}
+	return strings.Join(append(steps, err.Error()), ": ")
+}
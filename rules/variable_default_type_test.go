@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_VariableDefaultTypeRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "compatible defaults",
+			Content: `
+variable "instance_count" {
+  type    = number
+  default = "3"
+}
+variable "tags" {
+  type    = map(string)
+  default = { Owner = "platform", Tier = 1 }
+}
+variable "settings" {
+  type = object({
+    name = string
+    size = optional(number, 10)
+  })
+  default = { name = "app" }
+}
+variable "subnet_ids" {
+  type    = list(string)
+  default = null
+}
+variable "anything" {
+  default = "value"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "primitive type",
+			Content: `
+variable "instance_count" {
+  type    = number
+  default = "ten"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableDefaultTypeRule(),
+					Message: `default of variable "instance_count" is not compatible with type number: a number is required`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "nested attribute",
+			Content: `
+variable "settings" {
+  type = object({
+    name  = string
+    ports = list(number)
+  })
+  default = {
+    name  = "app"
+    ports = [80, "https"]
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableDefaultTypeRule(),
+					Message: `default of variable "settings" is not compatible with type object({name=string,ports=list(number)}): attribute "ports": element 1: a number is required`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 10, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing required attribute",
+			Content: `
+variable "settings" {
+  type = object({
+    name = string
+    size = optional(number)
+  })
+  default = { size = 1 }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableDefaultTypeRule(),
+					Message: `default of variable "settings" is not compatible with type object({name=string,size=number}): attribute "name" is required`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 25},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewVariableDefaultTypeRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"variables.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
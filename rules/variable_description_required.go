@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// variableDescriptionRequiredRuleConfig is the config structure for the variable_description_required rule
+type variableDescriptionRequiredRuleConfig struct {
+	MinimumLength int `hclext:"minimum_length,optional"`
+}
+
+// VariableDescriptionRequiredRule checks whether variables have a description
+type VariableDescriptionRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewVariableDescriptionRequiredRule returns a new rule
+func NewVariableDescriptionRequiredRule() *VariableDescriptionRequiredRule {
+	return &VariableDescriptionRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *VariableDescriptionRequiredRule) Name() string {
+	return "variable_description_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VariableDescriptionRequiredRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *VariableDescriptionRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *VariableDescriptionRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VariableDescriptionRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require a non-empty description on every variable",
+		Tags:        []string{TagDocumentation},
+		Config: []ConfigOption{
+			{Name: "minimum_length", Description: "Minimum length of the description", Default: "1"},
+		},
+		Example: `
+variable "region" {
+  type = string
+}
+`,
+	}
+}
+
+// Check emits issues for variables without a description or with a description that is too short
+func (r *VariableDescriptionRequiredRule) Check(runner tflint.Runner) error {
+	config := &variableDescriptionRequiredRuleConfig{MinimumLength: 1}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "description"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		attr, exists := variable.Body.Attributes["description"]
+		if !exists {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("variable %q should have a description", variable.Labels[0]),
+				variable.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(description string) error {
+			if len(strings.TrimSpace(description)) >= config.MinimumLength {
+				return nil
+			}
+			if config.MinimumLength <= 1 {
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf("variable %q should have a non-empty description", variable.Labels[0]),
+					attr.Range,
+				)
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("variable %q description should be at least %d characters long", variable.Labels[0], config.MinimumLength),
+				attr.Range,
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_VariableDescriptionRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Severity tflint.Severity
+	}{
+		{
+			Name: "with description",
+			Content: map[string]string{
+				"variables.tf": `
+variable "v" {
+  description = "The value"
+}
+`,
+			},
+			Expected: helper.Issues{},
+			Severity: tflint.WARNING,
+		},
+		{
+			Name: "missing description",
+			Content: map[string]string{
+				"variables.tf": `
+variable "v" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableDescriptionRequiredRule(),
+					Message: `variable "v" should have a description`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 13},
+					},
+				},
+			},
+			Severity: tflint.WARNING,
+		},
+		{
+			Name: "empty description",
+			Content: map[string]string{
+				"variables.tf": `
+variable "v" {
+  description = " "
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableDescriptionRequiredRule(),
+					Message: `variable "v" should have a non-empty description`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 20},
+					},
+				},
+			},
+			Severity: tflint.WARNING,
+		},
+		{
+			Name: "minimum length and severity",
+			Content: map[string]string{
+				"variables.tf": `
+variable "v" {
+  description = "x"
+}
+`,
+				".tflint.hcl": `
+rule "variable_description_required" {
+  enabled        = true
+  minimum_length = 10
+  severity       = "ERROR"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    &severityOverride{Rule: NewVariableDescriptionRequiredRule(), severity: tflint.ERROR},
+					Message: `variable "v" description should be at least 10 characters long`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 20},
+					},
+				},
+			},
+			Severity: tflint.ERROR,
+		},
+	}
+
+	rule := NewVariableDescriptionRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(&globalRunner{Runner: runner, config: &GlobalConfig{}}); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			for _, issue := range runner.Issues {
+				if issue.Rule.Severity() != tc.Severity {
+					t.Fatalf("Expected severity %s, got %s", tc.Severity, issue.Rule.Severity())
+				}
+			}
+		})
+	}
+}
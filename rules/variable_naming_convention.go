@@ -0,0 +1,106 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/internal/naming"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// variableNamingConventionRuleConfig is the config structure for the variable_naming_convention rule
+type variableNamingConventionRuleConfig struct {
+	Format string `hclext:"format,optional"`
+	Custom string `hclext:"custom,optional"`
+}
+
+// VariableNamingConventionRule checks whether variable names follow a naming convention
+type VariableNamingConventionRule struct {
+	tflint.DefaultRule
+}
+
+// NewVariableNamingConventionRule returns a new rule
+func NewVariableNamingConventionRule() *VariableNamingConventionRule {
+	return &VariableNamingConventionRule{}
+}
+
+// Name returns the rule name
+func (r *VariableNamingConventionRule) Name() string {
+	return "variable_naming_convention"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VariableNamingConventionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *VariableNamingConventionRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *VariableNamingConventionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VariableNamingConventionRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Enforce a naming convention on variable names",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "format", Description: "snake_case, camelCase, PascalCase, kebab-case or custom", Default: "snake_case"},
+			{Name: "custom", Description: "Regular expression used when format is custom", Default: ""},
+		},
+		Example: `
+variable "instanceType" {
+  type = string
+}
+`,
+	}
+}
+
+// Check emits issues for variable names that do not match the configured format
+func (r *VariableNamingConventionRule) Check(runner tflint.Runner) error {
+	format, custom := namingDefaults(runner)
+	config := &variableNamingConventionRuleConfig{Format: format, Custom: custom}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	convention, err := naming.New(config.Format, config.Custom)
+	if err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		name := variable.Labels[0]
+		if convention.Match(name) {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("variable name %q must match the following %s", name, convention),
+			variable.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_VariableNamingConventionRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "snake_case by default",
+			Content: map[string]string{
+				"variables.tf": `
+variable "instance_type" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "camelCase violates snake_case",
+			Content: map[string]string{
+				"variables.tf": `
+variable "instanceType" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableNamingConventionRule(),
+					Message: `variable name "instanceType" must match the following format: snake_case`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "kebab-case format",
+			Content: map[string]string{
+				"variables.tf": `
+variable "instance-type" {}
+variable "instance_type" {}
+`,
+				".tflint.hcl": `
+rule "variable_naming_convention" {
+  enabled = true
+  format  = "kebab-case"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableNamingConventionRule(),
+					Message: `variable name "instance_type" must match the following format: kebab-case`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom format",
+			Content: map[string]string{
+				"variables.tf": `
+variable "in_name" {}
+variable "name" {}
+`,
+				".tflint.hcl": `
+rule "variable_naming_convention" {
+  enabled = true
+  format  = "custom"
+  custom  = "^in_"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableNamingConventionRule(),
+					Message: `variable name "name" must match the following RegExp: ^in_`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 16},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewVariableNamingConventionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
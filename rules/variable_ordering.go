@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// variableOrderingRuleConfig is the config structure for the variable_ordering rule
+type variableOrderingRuleConfig struct {
+	RequiredFirst bool `hclext:"required_first,optional"`
+}
+
+// VariableOrderingRule checks whether variables in variables.tf are sorted
+type VariableOrderingRule struct {
+	tflint.DefaultRule
+}
+
+// NewVariableOrderingRule returns a new rule
+func NewVariableOrderingRule() *VariableOrderingRule {
+	return &VariableOrderingRule{}
+}
+
+// Name returns the rule name
+func (r *VariableOrderingRule) Name() string {
+	return "variable_ordering"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VariableOrderingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *VariableOrderingRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *VariableOrderingRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VariableOrderingRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require variables in variables.tf to be sorted alphabetically",
+		Tags:        []string{TagStyle},
+		Config: []ConfigOption{
+			{Name: "required_first", Description: "Sort variables without a default before the others", Default: "false"},
+		},
+		Example: `
+# variables.tf
+variable "region" {}
+variable "name" {}
+`,
+	}
+}
+
+// Check emits an issue for the first variable in variables.tf that is out of alphabetical order.
+// With required_first, variables without a default must come before optional ones.
+func (r *VariableOrderingRule) Check(runner tflint.Runner) error {
+	config := &variableOrderingRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "default"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	sorting := "alphabetically"
+	less := func(a, b *hclext.Block) bool {
+		return a.Labels[0] < b.Labels[0]
+	}
+	if config.RequiredFirst {
+		sorting = "required first, then alphabetically"
+		less = func(a, b *hclext.Block) bool {
+			_, aOptional := a.Body.Attributes["default"]
+			_, bOptional := b.Body.Attributes["default"]
+			if aOptional != bOptional {
+				return bOptional
+			}
+			return a.Labels[0] < b.Labels[0]
+		}
+	}
+
+	order := newBlockOrder(body.Blocks, filenameVariables, less)
+	block, position := order.firstOutOfOrder()
+	if block == nil {
+		return nil
+	}
+
+	return runner.EmitIssueWithFix(
+		r,
+		fmt.Sprintf(`variable %q is out of order, expected at position %d when sorted %s`, block.Labels[0], position, sorting),
+		block.DefRange,
+		order.fix(runner),
+	)
+}
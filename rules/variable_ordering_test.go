@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_VariableOrderingRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Fixed    map[string]string
+	}{
+		{
+			Name: "sorted",
+			Content: map[string]string{
+				"variables.tf": `
+variable "a" {}
+variable "b" {}
+variable "c" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "other files are ignored",
+			Content: map[string]string{
+				"main.tf": `
+variable "b" {}
+variable "a" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "out of order",
+			Content: map[string]string{
+				"variables.tf": `
+variable "a" {}
+variable "c" {}
+variable "b" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableOrderingRule(),
+					Message: `variable "c" is out of order, expected at position 3 when sorted alphabetically`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 13},
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"variables.tf": `
+variable "a" {}
+variable "b" {}
+variable "c" {}
+`,
+			},
+		},
+		{
+			Name: "required first",
+			Content: map[string]string{
+				"variables.tf": `
+variable "b" {}
+variable "d" {}
+variable "a" {
+  default = 1
+}
+variable "c" {
+  default = 2
+}
+`,
+				".tflint.hcl": `
+rule "variable_ordering" {
+  enabled        = true
+  required_first = true
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "optional before required",
+			Content: map[string]string{
+				"variables.tf": `
+variable "a" {
+  default = 1
+}
+variable "b" {}
+`,
+				".tflint.hcl": `
+rule "variable_ordering" {
+  enabled        = true
+  required_first = true
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableOrderingRule(),
+					Message: `variable "a" is out of order, expected at position 2 when sorted required first, then alphabetically`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 13},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewVariableOrderingRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			if tc.Fixed != nil {
+				helper.AssertChanges(t, tc.Fixed, runner.Changes())
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// reservedVariableNames are names that are meta-arguments of module blocks or words of the Terraform language
+var reservedVariableNames = map[string]bool{
+	"count":      true,
+	"depends_on": true,
+	"for_each":   true,
+	"lifecycle":  true,
+	"locals":     true,
+	"module":     true,
+	"provider":   true,
+	"providers":  true,
+	"source":     true,
+	"terraform":  true,
+	"version":    true,
+}
+
+// variableReservedNameRuleConfig is the config structure for the variable_reserved_name rule
+type variableReservedNameRuleConfig struct {
+	AdditionalNames []string `hclext:"additional_names,optional"`
+}
+
+// VariableReservedNameRule checks whether variable names collide with reserved words
+type VariableReservedNameRule struct {
+	tflint.DefaultRule
+}
+
+// NewVariableReservedNameRule returns a new rule
+func NewVariableReservedNameRule() *VariableReservedNameRule {
+	return &VariableReservedNameRule{}
+}
+
+// Name returns the rule name
+func (r *VariableReservedNameRule) Name() string {
+	return "variable_reserved_name"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VariableReservedNameRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *VariableReservedNameRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *VariableReservedNameRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VariableReservedNameRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow variable names that collide with module meta-arguments or language keywords, such as source or count",
+		Tags:        []string{TagCorrectness},
+		Config: []ConfigOption{
+			{Name: "additional_names", Description: "Names to disallow on top of the meta-arguments and keywords", Default: "[]"},
+		},
+		Example: `
+variable "version" {
+  type = string
+}
+`,
+	}
+}
+
+// Check emits an issue for every variable whose name is reserved
+func (r *VariableReservedNameRule) Check(runner tflint.Runner) error {
+	config := &variableReservedNameRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	additional := make(map[string]bool, len(config.AdditionalNames))
+	for _, name := range config.AdditionalNames {
+		additional[name] = true
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "variable", LabelNames: []string{"name"}},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		name := variable.Labels[0]
+		if !reservedVariableNames[name] && !additional[name] {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("variable name %q is reserved or ambiguous in module blocks, choose a more specific name", name),
+			variable.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
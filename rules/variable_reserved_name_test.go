@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_VariableReservedNameRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "specific names",
+			Content: map[string]string{
+				"main.tf": `
+variable "engine_version" {}
+variable "instance_count" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "reserved name",
+			Content: map[string]string{
+				"main.tf": `
+variable "source" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableReservedNameRule(),
+					Message: `variable name "source" is reserved or ambiguous in module blocks, choose a more specific name`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "additional names",
+			Content: map[string]string{
+				"main.tf": `
+variable "name" {}
+`,
+				".tflint.hcl": `
+rule "variable_reserved_name" {
+  enabled          = true
+  additional_names = ["name"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableReservedNameRule(),
+					Message: `variable name "name" is reserved or ambiguous in module blocks, choose a more specific name`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 16},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewVariableReservedNameRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// variableSensitiveRequiredRuleConfig is the config structure for the variable_sensitive_required rule
+type variableSensitiveRequiredRuleConfig struct {
+	Patterns []string `hclext:"patterns,optional"`
+}
+
+// VariableSensitiveRequiredRule checks whether sensitive-looking variables set sensitive = true
+type VariableSensitiveRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewVariableSensitiveRequiredRule returns a new rule
+func NewVariableSensitiveRequiredRule() *VariableSensitiveRequiredRule {
+	return &VariableSensitiveRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *VariableSensitiveRequiredRule) Name() string {
+	return "variable_sensitive_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VariableSensitiveRequiredRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *VariableSensitiveRequiredRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *VariableSensitiveRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VariableSensitiveRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require sensitive = true on variables whose names look sensitive",
+		Tags:        []string{TagSecurity},
+		Config: []ConfigOption{
+			{Name: "patterns", Description: "Substrings of variable names that look sensitive", Default: `["password", "secret", "token", "key"]`},
+		},
+		Example: `
+variable "db_password" {
+  type = string
+}
+`,
+	}
+}
+
+// Check emits issues for variables whose names match a sensitive pattern but are not marked sensitive
+func (r *VariableSensitiveRequiredRule) Check(runner tflint.Runner) error {
+	config := &variableSensitiveRequiredRuleConfig{
+		Patterns: []string{"password", "secret", "token", "key"},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	patterns := make([]*regexp.Regexp, len(config.Patterns))
+	for i, p := range config.Patterns {
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+		patterns[i] = pattern
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "sensitive"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		name := variable.Labels[0]
+		matched := false
+		for _, pattern := range patterns {
+			if pattern.MatchString(name) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		message := fmt.Sprintf("variable %q looks sensitive and should set sensitive = true", name)
+
+		attr, exists := variable.Body.Attributes["sensitive"]
+		if !exists {
+			if err := runner.EmitIssue(r, message, variable.DefRange); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(sensitive bool) error {
+			if sensitive {
+				return nil
+			}
+			return runner.EmitIssue(r, message, attr.Range)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_VariableSensitiveRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Severity tflint.Severity
+	}{
+		{
+			Name: "sensitive variable",
+			Content: map[string]string{
+				"variables.tf": `
+variable "db_password" {
+  sensitive = true
+}
+variable "region" {}
+`,
+			},
+			Expected: helper.Issues{},
+			Severity: tflint.ERROR,
+		},
+		{
+			Name: "missing sensitive",
+			Content: map[string]string{
+				"variables.tf": `
+variable "api_token" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableSensitiveRequiredRule(),
+					Message: `variable "api_token" looks sensitive and should set sensitive = true`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 21},
+					},
+				},
+			},
+			Severity: tflint.ERROR,
+		},
+		{
+			Name: "sensitive false",
+			Content: map[string]string{
+				"variables.tf": `
+variable "client_secret" {
+  sensitive = false
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableSensitiveRequiredRule(),
+					Message: `variable "client_secret" looks sensitive and should set sensitive = true`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 20},
+					},
+				},
+			},
+			Severity: tflint.ERROR,
+		},
+		{
+			Name: "custom patterns and severity",
+			Content: map[string]string{
+				"variables.tf": `
+variable "api_token" {}
+variable "pin" {}
+`,
+				".tflint.hcl": `
+rule "variable_sensitive_required" {
+  enabled  = true
+  patterns = ["^pin$"]
+  severity = "warning"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    &severityOverride{Rule: NewVariableSensitiveRequiredRule(), severity: tflint.WARNING},
+					Message: `variable "pin" looks sensitive and should set sensitive = true`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 15},
+					},
+				},
+			},
+			Severity: tflint.WARNING,
+		},
+	}
+
+	rule := NewVariableSensitiveRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(&globalRunner{Runner: runner, config: &GlobalConfig{}}); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			for _, issue := range runner.Issues {
+				if issue.Rule.Severity() != tc.Severity {
+					t.Fatalf("Expected severity %s, got %s", tc.Severity, issue.Rule.Severity())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// variableTypeNoAnyRuleConfig is the config structure for the variable_type_no_any rule
+type variableTypeNoAnyRuleConfig struct {
+	Strict bool `hclext:"strict,optional"`
+}
+
+// VariableTypeNoAnyRule checks whether variables are typed as any
+type VariableTypeNoAnyRule struct {
+	tflint.DefaultRule
+}
+
+// NewVariableTypeNoAnyRule returns a new rule
+func NewVariableTypeNoAnyRule() *VariableTypeNoAnyRule {
+	return &VariableTypeNoAnyRule{}
+}
+
+// Name returns the rule name
+func (r *VariableTypeNoAnyRule) Name() string {
+	return "variable_type_no_any"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VariableTypeNoAnyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *VariableTypeNoAnyRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *VariableTypeNoAnyRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VariableTypeNoAnyRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow the any type on variables",
+		Tags:        []string{TagCorrectness},
+		Config: []ConfigOption{
+			{Name: "strict", Description: "Also report any nested in collection and structural types, such as list(any)", Default: "false"},
+		},
+		Example: `
+variable "settings" {
+  type = any
+}
+`,
+	}
+}
+
+// Check emits issues for variables whose type is any, or contains any in strict mode
+func (r *VariableTypeNoAnyRule) Check(runner tflint.Runner) error {
+	config := &variableTypeNoAnyRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "type"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		attr, exists := variable.Body.Attributes["type"]
+		if !exists {
+			continue
+		}
+		ty, _, diags := typeexpr.TypeConstraintWithDefaults(attr.Expr)
+		if diags.HasErrors() {
+			// Invalid type constraints are reported by Terraform itself
+			continue
+		}
+
+		if ty == cty.DynamicPseudoType {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("variable %q should not use type any, declare a concrete type such as object({ ... }) instead", variable.Labels[0]),
+				attr.Expr.Range(),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+		if config.Strict && ty.HasDynamicTypes() {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("variable %q should not use any in type %s, declare a concrete element type such as an object type instead", variable.Labels[0], typeexpr.TypeString(ty)),
+				attr.Expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_VariableTypeNoAnyRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "concrete type",
+			Content: map[string]string{
+				"variables.tf": `
+variable "settings" {
+  type = object({
+    name = string
+    size = optional(number, 1)
+  })
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "any",
+			Content: map[string]string{
+				"variables.tf": `
+variable "settings" {
+  type = any
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableTypeNoAnyRule(),
+					Message: `variable "settings" should not use type any, declare a concrete type such as object({ ... }) instead`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 3, Column: 13},
+					},
+				},
+			},
+		},
+		{
+			Name: "nested any",
+			Content: map[string]string{
+				"variables.tf": `
+variable "settings" {
+  type = map(any)
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "nested any in strict mode",
+			Content: map[string]string{
+				"variables.tf": `
+variable "settings" {
+  type = map(any)
+}
+variable "users" {
+  type = list(object({ name = string, extra = any }))
+}
+`,
+				".tflint.hcl": `
+rule "variable_type_no_any" {
+  enabled = true
+  strict  = true
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableTypeNoAnyRule(),
+					Message: `variable "settings" should not use any in type map(any), declare a concrete element type such as an object type instead`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 3, Column: 18},
+					},
+				},
+				{
+					Rule:    NewVariableTypeNoAnyRule(),
+					Message: `variable "users" should not use any in type list(object({extra=any,name=string})), declare a concrete element type such as an object type instead`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 6, Column: 10},
+						End:      hcl.Pos{Line: 6, Column: 54},
+					},
+				},
+			},
+		},
+		{
+			Name: "json",
+			Content: map[string]string{
+				"variables.tf.json": `{"variable": {"settings": {"type": "any"}}}`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableTypeNoAnyRule(),
+					Message: `variable "settings" should not use type any, declare a concrete type such as object({ ... }) instead`,
+					Range: hcl.Range{
+						Filename: "variables.tf.json",
+						Start:    hcl.Pos{Line: 1, Column: 36},
+						End:      hcl.Pos{Line: 1, Column: 41},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewVariableTypeNoAnyRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// VariableTypeRequiredRule checks whether variables declare an explicit type
+type VariableTypeRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewVariableTypeRequiredRule returns a new rule
+func NewVariableTypeRequiredRule() *VariableTypeRequiredRule {
+	return &VariableTypeRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *VariableTypeRequiredRule) Name() string {
+	return "variable_type_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VariableTypeRequiredRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *VariableTypeRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *VariableTypeRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VariableTypeRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require an explicit type on every variable",
+		Tags:        []string{TagCorrectness},
+		Example: `
+variable "region" {
+  description = "Region to deploy to"
+}
+`,
+	}
+}
+
+// Check emits issues for variables without a type argument
+func (r *VariableTypeRequiredRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "type"}},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		if _, exists := variable.Body.Attributes["type"]; exists {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("variable %q should declare a type", variable.Labels[0]),
+			variable.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
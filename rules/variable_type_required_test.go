@@ -0,0 +1,63 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_VariableTypeRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "with type",
+			Content: map[string]string{
+				"variables.tf": `
+variable "v" {
+  type = string
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "without type",
+			Content: map[string]string{
+				"variables.tf": `
+variable "v" {
+  default = "x"
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableTypeRequiredRule(),
+					Message: `variable "v" should declare a type`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 13},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewVariableTypeRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
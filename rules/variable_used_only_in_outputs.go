@@ -0,0 +1,157 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/internal/refs"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// variableUsedOnlyInOutputsRuleConfig is the config structure for the variable_used_only_in_outputs rule
+type variableUsedOnlyInOutputsRuleConfig struct {
+	ExemptVariables []string `hclext:"exempt_variables,optional"`
+}
+
+// VariableUsedOnlyInOutputsRule checks whether variables are only referenced by outputs
+type VariableUsedOnlyInOutputsRule struct {
+	tflint.DefaultRule
+}
+
+// NewVariableUsedOnlyInOutputsRule returns a new rule
+func NewVariableUsedOnlyInOutputsRule() *VariableUsedOnlyInOutputsRule {
+	return &VariableUsedOnlyInOutputsRule{}
+}
+
+// Name returns the rule name
+func (r *VariableUsedOnlyInOutputsRule) Name() string {
+	return "variable_used_only_in_outputs"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VariableUsedOnlyInOutputsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *VariableUsedOnlyInOutputsRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *VariableUsedOnlyInOutputsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VariableUsedOnlyInOutputsRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow variables that are only referenced by outputs, directly or through locals",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "exempt_variables", Description: "Variable names that may only be referenced by outputs", Default: "[]"},
+		},
+		Example: `
+variable "domain" {
+  type = string
+}
+
+output "url" {
+  value = "https://${var.domain}"
+}
+`,
+	}
+}
+
+// Check emits issues for variables whose every reference is in an output, or in a local only referenced by outputs.
+// Unused variables are left to unused_variable, and references from a variable's own validation blocks are ignored.
+func (r *VariableUsedOnlyInOutputsRule) Check(runner tflint.Runner) error {
+	config := &variableUsedOnlyInOutputsRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	exempt := make(map[string]bool, len(config.ExemptVariables))
+	for _, name := range config.ExemptVariables {
+		exempt[name] = true
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "variable", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	graph, err := moduleReferences(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		name := variable.Labels[0]
+		if exempt[name] {
+			continue
+		}
+
+		outputs, ok := referencingOutputs(graph, "var."+name, map[string]bool{})
+		if !ok || len(outputs) == 0 {
+			continue
+		}
+		sort.Strings(outputs)
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf(
+				"variable %q is only referenced by %s, the caller should produce this value instead of passing it through the module",
+				name,
+				strings.Join(outputs, ", "),
+			),
+			variable.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// referencingOutputs returns the outputs that reference addr directly or through locals, and false if anything
+// else references it. References from addr's own block and from JSON files, whose block is unknown, end the search.
+func referencingOutputs(graph *refs.Graph, addr string, visiting map[string]bool) ([]string, bool) {
+	visiting[addr] = true
+	defer delete(visiting, addr)
+
+	seen := map[string]bool{}
+	outputs := []string{}
+	for _, ref := range graph.To(addr) {
+		switch {
+		case ref.From == addr:
+			continue
+		case strings.HasPrefix(ref.From, "output."):
+			if !seen[ref.From] {
+				seen[ref.From] = true
+				outputs = append(outputs, ref.From)
+			}
+		case strings.HasPrefix(ref.From, "local.") && !visiting[ref.From]:
+			via, ok := referencingOutputs(graph, ref.From, visiting)
+			if !ok {
+				return nil, false
+			}
+			for _, output := range via {
+				if !seen[output] {
+					seen[output] = true
+					outputs = append(outputs, output)
+				}
+			}
+		default:
+			return nil, false
+		}
+	}
+	return outputs, true
+}
@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_VariableUsedOnlyInOutputsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "used by resources",
+			Content: `
+variable "domain" {
+  type = string
+}
+
+variable "unused" {
+  type = string
+}
+
+locals {
+  fqdn = "app.${var.domain}"
+}
+
+resource "aws_route53_record" "app" {
+  name = local.fqdn
+}
+
+output "url" {
+  value = "https://${var.domain}"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "only used by outputs",
+			Content: `
+variable "domain" {
+  type = string
+
+  validation {
+    condition     = length(var.domain) > 0
+    error_message = "The domain must not be empty."
+  }
+}
+
+variable "owner" {
+  type = string
+}
+
+locals {
+  fqdn  = "app.${var.domain}"
+  label = local.fqdn
+}
+
+output "url" {
+  value = "https://${local.label}"
+}
+
+output "domain" {
+  value = upper(var.domain)
+}
+
+output "owner" {
+  value = var.owner
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableUsedOnlyInOutputsRule(),
+					Message: `variable "domain" is only referenced by output.domain, output.url, the caller should produce this value instead of passing it through the module`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+				{
+					Rule:    NewVariableUsedOnlyInOutputsRule(),
+					Message: `variable "owner" is only referenced by output.owner, the caller should produce this value instead of passing it through the module`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 11, Column: 1},
+						End:      hcl.Pos{Line: 11, Column: 17},
+					},
+				},
+			},
+		},
+		{
+			Name: "exempt variables",
+			Content: `
+variable "owner" {
+  type = string
+}
+
+output "owner" {
+  value = var.owner
+}
+`,
+			Config: `
+rule "variable_used_only_in_outputs" {
+  enabled          = true
+  exempt_variables = ["owner"]
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewVariableUsedOnlyInOutputsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
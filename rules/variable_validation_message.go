@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// VariableValidationMessageRule checks whether variable validation blocks have actionable error messages
+// and conditions on the variable itself
+type VariableValidationMessageRule struct {
+	tflint.DefaultRule
+}
+
+// NewVariableValidationMessageRule returns a new rule
+func NewVariableValidationMessageRule() *VariableValidationMessageRule {
+	return &VariableValidationMessageRule{}
+}
+
+// Name returns the rule name
+func (r *VariableValidationMessageRule) Name() string {
+	return "variable_validation_message"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VariableValidationMessageRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *VariableValidationMessageRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *VariableValidationMessageRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VariableValidationMessageRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require validation blocks to have a non-empty error_message ending with punctuation and a condition on the variable",
+		Tags:        []string{TagCorrectness},
+		Example: `
+variable "environment" {
+  type = string
+
+  validation {
+    condition     = contains(["dev", "prd"], local.environment)
+    error_message = "invalid environment"
+  }
+}
+`,
+	}
+}
+
+// Check emits issues for validation blocks whose error_message is missing, empty or does not end with
+// punctuation, and whose condition does not reference the variable being validated
+func (r *VariableValidationMessageRule) Check(runner tflint.Runner) error {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "validation",
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{{Name: "condition"}, {Name: "error_message"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		name := variable.Labels[0]
+		for _, validation := range variable.Body.Blocks {
+			if condition, exists := validation.Body.Attributes["condition"]; exists {
+				referenced := false
+				for _, ref := range referencedNames(condition.Expr, "var") {
+					if ref == name {
+						referenced = true
+					}
+				}
+				if !referenced {
+					if err := runner.EmitIssue(
+						r,
+						fmt.Sprintf("validation condition of variable %q should reference var.%s", name, name),
+						condition.Expr.Range(),
+					); err != nil {
+						return err
+					}
+				}
+			}
+
+			message, exists := validation.Body.Attributes["error_message"]
+			if !exists {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("validation of variable %q should have an error_message", name),
+					validation.DefRange,
+				); err != nil {
+					return err
+				}
+				continue
+			}
+
+			err := runner.EvaluateExpr(message.Expr, func(text string) error {
+				text = strings.TrimSpace(text)
+				if text == "" {
+					return runner.EmitIssue(
+						r,
+						fmt.Sprintf("validation error_message of variable %q should not be empty", name),
+						message.Expr.Range(),
+					)
+				}
+				if !strings.ContainsAny(text[len(text)-1:], ".!?") {
+					return runner.EmitIssue(
+						r,
+						fmt.Sprintf("validation error_message of variable %q should be a sentence ending with punctuation", name),
+						message.Expr.Range(),
+					)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_VariableValidationMessageRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "actionable validation",
+			Content: map[string]string{
+				"variables.tf": `
+variable "environment" {
+  type = string
+
+  validation {
+    condition     = contains(["dev", "prd"], var.environment)
+    error_message = "The environment must be dev or prd."
+  }
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "condition on another value",
+			Content: map[string]string{
+				"variables.tf": `
+variable "environment" {
+  validation {
+    condition     = contains(["dev", "prd"], local.environment)
+    error_message = "The environment must be dev or prd."
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableValidationMessageRule(),
+					Message: `validation condition of variable "environment" should reference var.environment`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 4, Column: 21},
+						End:      hcl.Pos{Line: 4, Column: 64},
+					},
+				},
+			},
+		},
+		{
+			Name: "message without punctuation",
+			Content: map[string]string{
+				"variables.tf": `
+variable "environment" {
+  validation {
+    condition     = var.environment != ""
+    error_message = "invalid environment"
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableValidationMessageRule(),
+					Message: `validation error_message of variable "environment" should be a sentence ending with punctuation`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 5, Column: 21},
+						End:      hcl.Pos{Line: 5, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "empty message",
+			Content: map[string]string{
+				"variables.tf": `
+variable "environment" {
+  validation {
+    condition     = var.environment != ""
+    error_message = " "
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableValidationMessageRule(),
+					Message: `validation error_message of variable "environment" should not be empty`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 5, Column: 21},
+						End:      hcl.Pos{Line: 5, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing message",
+			Content: map[string]string{
+				"variables.tf": `
+variable "environment" {
+  validation {
+    condition = var.environment != ""
+  }
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableValidationMessageRule(),
+					Message: `validation of variable "environment" should have an error_message`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 13},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewVariableValidationMessageRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// variableValidationRequiredRuleConfig is the config structure for the variable_validation_required rule
+type variableValidationRequiredRuleConfig struct {
+	NamePatterns []string `hclext:"name_patterns,optional"`
+	Types        []string `hclext:"types,optional"`
+}
+
+// VariableValidationRequiredRule checks whether constrained variables declare a validation block
+type VariableValidationRequiredRule struct {
+	tflint.DefaultRule
+}
+
+// NewVariableValidationRequiredRule returns a new rule
+func NewVariableValidationRequiredRule() *VariableValidationRequiredRule {
+	return &VariableValidationRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *VariableValidationRequiredRule) Name() string {
+	return "variable_validation_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VariableValidationRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *VariableValidationRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *VariableValidationRequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VariableValidationRequiredRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Require validation blocks on variables matching configured names or types",
+		Tags:        []string{TagBestPractice},
+		Config: []ConfigOption{
+			{Name: "name_patterns", Description: "Regular expressions of variable names that need validation", Default: `["_cidr$", "^environment$"]`},
+			{Name: "types", Description: "Variable types that need validation", Default: "[]"},
+		},
+		Example: `
+variable "vpc_cidr" {
+  type = string
+}
+`,
+	}
+}
+
+// Check emits issues for variables without a validation block whose name matches name_patterns
+// or whose type is listed in types
+func (r *VariableValidationRequiredRule) Check(runner tflint.Runner) error {
+	config := &variableValidationRequiredRuleConfig{
+		NamePatterns: []string{"_cidr$", "^environment$"},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	patterns := make([]*regexp.Regexp, 0, len(config.NamePatterns))
+	for _, p := range config.NamePatterns {
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return fmt.Errorf("invalid name pattern %q: %w", p, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	types := map[string]bool{}
+	for _, t := range config.Types {
+		types[stripSpaces(t)] = true
+	}
+
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "type"}},
+					Blocks: []hclext.BlockSchema{
+						{Type: "validation", Body: &hclext.BodySchema{}},
+					},
+				},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	for _, variable := range body.Blocks {
+		if len(variable.Body.Blocks.OfType("validation")) > 0 {
+			continue
+		}
+
+		name := variable.Labels[0]
+		constrained := matchesAny(patterns, name)
+		if attr, exists := variable.Body.Attributes["type"]; exists && !constrained && len(types) > 0 {
+			file, err := runner.GetFile(attr.Expr.Range().Filename)
+			if err != nil {
+				return err
+			}
+			constrained = types[stripSpaces(string(attr.Expr.Range().SliceBytes(file.Bytes)))]
+		}
+		if !constrained {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("variable %q should declare a validation block", name),
+			variable.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stripSpaces removes all whitespace so that type expressions compare regardless of formatting
+func stripSpaces(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
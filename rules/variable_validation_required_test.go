@@ -0,0 +1,106 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_VariableValidationRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+	}{
+		{
+			Name: "validated",
+			Content: map[string]string{
+				"variables.tf": `
+variable "vpc_cidr" {
+  validation {
+    condition     = can(cidrhost(var.vpc_cidr, 0))
+    error_message = "Must be a valid CIDR block."
+  }
+}
+variable "name" {}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing validation",
+			Content: map[string]string{
+				"variables.tf": `
+variable "vpc_cidr" {}
+variable "environment" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableValidationRequiredRule(),
+					Message: `variable "vpc_cidr" should declare a validation block`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 20},
+					},
+				},
+				{
+					Rule:    NewVariableValidationRequiredRule(),
+					Message: `variable "environment" should declare a validation block`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "configured types",
+			Content: map[string]string{
+				"variables.tf": `
+variable "ports" {
+  type = list( number )
+}
+variable "name" {
+  type = string
+}
+`,
+				".tflint.hcl": `
+rule "variable_validation_required" {
+  enabled       = true
+  name_patterns = []
+  types         = ["number", "list(number)"]
+}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariableValidationRequiredRule(),
+					Message: `variable "ports" should declare a validation block`,
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 17},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewVariableValidationRequiredRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// misplacedBlockTypes are the block types that do not belong in variables.tf and outputs.tf
+var misplacedBlockTypes = map[string]map[string]bool{
+	filenameVariables: {"resource": true, "data": true, "module": true, "output": true},
+	filenameOutputs:   {"resource": true, "data": true, "module": true, "variable": true},
+}
+
+// VariablesOutputsFilesOnlyRule checks whether variables.tf and outputs.tf declare resources or each other's blocks
+type VariablesOutputsFilesOnlyRule struct {
+	tflint.DefaultRule
+}
+
+// NewVariablesOutputsFilesOnlyRule returns a new rule
+func NewVariablesOutputsFilesOnlyRule() *VariablesOutputsFilesOnlyRule {
+	return &VariablesOutputsFilesOnlyRule{}
+}
+
+// Name returns the rule name
+func (r *VariablesOutputsFilesOnlyRule) Name() string {
+	return "variables_outputs_files_only"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VariablesOutputsFilesOnlyRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *VariablesOutputsFilesOnlyRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *VariablesOutputsFilesOnlyRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VariablesOutputsFilesOnlyRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Disallow resources, data sources and module calls in variables.tf and outputs.tf, and outputs and variables in each other's file",
+		Tags:        []string{TagStructure},
+		Example: `
+# outputs.tf
+resource "aws_s3_bucket" "logs" {}
+
+output "logs_bucket" {
+  value = aws_s3_bucket.logs.id
+}
+`,
+	}
+}
+
+// Check emits issues for resource, data and module blocks in variables.tf and outputs.tf, for output blocks in
+// variables.tf and for variable blocks in outputs.tf. Variables and outputs are moved to their conventional files,
+// other blocks to main.tf.
+func (r *VariablesOutputsFilesOnlyRule) Check(runner tflint.Runner) error {
+	_, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, filename := range []string{filenameVariables, filenameOutputs} {
+		file, exists := files[filename]
+		if !exists {
+			continue
+		}
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if !misplacedBlockTypes[filename][block.Type] {
+				continue
+			}
+
+			target := filenameMain
+			switch block.Type {
+			case "variable":
+				target = filenameVariables
+			case "output":
+				target = filenameOutputs
+			}
+
+			hclBlock := block.AsHCLBlock()
+			extBlock := &hclext.Block{
+				Type:        hclBlock.Type,
+				Labels:      hclBlock.Labels,
+				DefRange:    hclBlock.DefRange,
+				TypeRange:   hclBlock.TypeRange,
+				LabelRanges: hclBlock.LabelRanges,
+			}
+
+			if err := runner.EmitIssueWithFix(
+				r,
+				fmt.Sprintf("%s block should be moved from %s to %s", block.Type, filename, target),
+				hclBlock.DefRange,
+				moveBlockFix(runner, extBlock, target),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
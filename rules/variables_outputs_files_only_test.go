@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_VariablesOutputsFilesOnlyRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Fixed    map[string]string
+	}{
+		{
+			Name: "conventional files",
+			Content: map[string]string{
+				"main.tf": `resource "aws_s3_bucket" "logs" {}`,
+				"variables.tf": `
+locals {
+  prefix = "app"
+}
+
+variable "region" {}
+`,
+				"outputs.tf": `
+output "logs_bucket" {
+  value = aws_s3_bucket.logs.id
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "misplaced blocks",
+			Content: map[string]string{
+				"main.tf": "",
+				"variables.tf": `variable "region" {}
+
+output "region" {
+  value = var.region
+}
+`,
+				"outputs.tf": `resource "aws_s3_bucket" "logs" {}
+
+variable "name" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVariablesOutputsFilesOnlyRule(),
+					Message: "output block should be moved from variables.tf to outputs.tf",
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 16},
+					},
+				},
+				{
+					Rule:    NewVariablesOutputsFilesOnlyRule(),
+					Message: "resource block should be moved from outputs.tf to main.tf",
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 1, Column: 1},
+						End:      hcl.Pos{Line: 1, Column: 32},
+					},
+				},
+				{
+					Rule:    NewVariablesOutputsFilesOnlyRule(),
+					Message: "variable block should be moved from outputs.tf to variables.tf",
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 3, Column: 1},
+						End:      hcl.Pos{Line: 3, Column: 16},
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"main.tf": `resource "aws_s3_bucket" "logs" {}
+`,
+				"variables.tf": `variable "region" {}
+
+
+variable "name" {}
+`,
+				"outputs.tf": `
+
+output "region" {
+  value = var.region
+}
+`,
+			},
+		},
+	}
+
+	rule := NewVariablesOutputsFilesOnlyRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			if tc.Fixed != nil {
+				helper.AssertChanges(t, tc.Fixed, runner.Changes())
+			}
+		})
+	}
+}
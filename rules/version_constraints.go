@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+// Version constraint policies
+const (
+	// constraintPolicyPessimistic accepts ranges with a lower and an upper bound, such as ~> 1.5
+	constraintPolicyPessimistic = "pessimistic"
+	// constraintPolicyMinimum accepts ranges with a lower bound, such as >= 1.5
+	constraintPolicyMinimum = "minimum"
+	// constraintPolicyExact accepts a single exact version, such as 1.5.7
+	constraintPolicyExact = "exact"
+)
+
+// constraintPolicies are the valid values of the policy option
+var constraintPolicies = map[string]bool{
+	constraintPolicyPessimistic: true,
+	constraintPolicyMinimum:     true,
+	constraintPolicyExact:       true,
+}
+
+// validateConstraintPolicy returns an error if the policy is not one of the constraint policies
+func validateConstraintPolicy(policy string) error {
+	if constraintPolicies[policy] {
+		return nil
+	}
+	names := make([]string, 0, len(constraintPolicies))
+	for name := range constraintPolicies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("%q is an invalid policy. Valid policies are %s", policy, strings.Join(names, ", "))
+}
+
+// constraintViolation returns why the version constraint does not follow the policy, or "" if it does
+func constraintViolation(constraint string, policy string) (string, error) {
+	if _, err := version.NewConstraint(constraint); err != nil {
+		return "", err
+	}
+
+	lower, upper, exact := false, false, false
+	for _, part := range strings.Split(constraint, ",") {
+		part = strings.TrimSpace(part)
+		switch {
+		case strings.HasPrefix(part, "~>"):
+			lower, upper = true, true
+		case strings.HasPrefix(part, ">"):
+			lower = true
+		case strings.HasPrefix(part, "<"):
+			upper = true
+		case strings.HasPrefix(part, "!="):
+		default:
+			// = or a bare version
+			exact = true
+		}
+	}
+
+	switch policy {
+	case constraintPolicyExact:
+		if !exact {
+			return "should pin an exact version", nil
+		}
+	case constraintPolicyMinimum:
+		if exact {
+			return "pins an exact version, use a minimum version such as >= instead", nil
+		}
+		if !lower {
+			return "has no lower bound, use a minimum version such as >= instead", nil
+		}
+	default:
+		if exact {
+			return "pins an exact version, use a ~> range instead", nil
+		}
+		if !lower || !upper {
+			return "is open-ended, use a ~> range instead", nil
+		}
+	}
+	return "", nil
+}
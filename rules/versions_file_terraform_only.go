@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// VersionsFileTerraformOnlyRule checks whether versions.tf contains anything but the terraform block
+type VersionsFileTerraformOnlyRule struct {
+	tflint.DefaultRule
+}
+
+// NewVersionsFileTerraformOnlyRule returns a new rule
+func NewVersionsFileTerraformOnlyRule() *VersionsFileTerraformOnlyRule {
+	return &VersionsFileTerraformOnlyRule{}
+}
+
+// Name returns the rule name
+func (r *VersionsFileTerraformOnlyRule) Name() string {
+	return "versions_file_terraform_only"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *VersionsFileTerraformOnlyRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *VersionsFileTerraformOnlyRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *VersionsFileTerraformOnlyRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule documentation
+func (r *VersionsFileTerraformOnlyRule) Metadata() interface{} {
+	return &Metadata{
+		Description: "Ensure that versions.tf contains only the terraform block",
+		Tags:        []string{TagStructure},
+		Example: `
+# versions.tf
+terraform {
+  required_version = ">= 1.0"
+}
+
+provider "aws" {
+  region = "us-east-1"
+}
+`,
+	}
+}
+
+// Check emits issues for top-level blocks other than terraform in versions.tf.
+// Variables and outputs are moved to their conventional files, other blocks to main.tf.
+func (r *VersionsFileTerraformOnlyRule) Check(runner tflint.Runner) error {
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		// This rule does not evaluate child modules.
+		return nil
+	}
+
+	_, files, err := moduleFiles(runner)
+	if err != nil {
+		return err
+	}
+	file, exists := files[filenameVersions]
+	if !exists {
+		// A missing versions.tf is left to terraform_versions_file.
+		return nil
+	}
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil
+	}
+
+	for _, block := range body.Blocks {
+		if block.Type == "terraform" {
+			continue
+		}
+
+		target := filenameMain
+		switch block.Type {
+		case "variable":
+			target = filenameVariables
+		case "output":
+			target = filenameOutputs
+		}
+
+		hclBlock := block.AsHCLBlock()
+		extBlock := &hclext.Block{
+			Type:        hclBlock.Type,
+			Labels:      hclBlock.Labels,
+			DefRange:    hclBlock.DefRange,
+			TypeRange:   hclBlock.TypeRange,
+			LabelRanges: hclBlock.LabelRanges,
+		}
+
+		if err := runner.EmitIssueWithFix(
+			r,
+			fmt.Sprintf("%s block should be moved from %s to %s", block.Type, hclBlock.DefRange.Filename, target),
+			hclBlock.DefRange,
+			moveBlockFix(runner, extBlock, target),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
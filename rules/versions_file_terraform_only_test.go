@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_VersionsFileTerraformOnlyRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected helper.Issues
+		Fixed    map[string]string
+	}{
+		{
+			Name: "missing versions.tf",
+			Content: map[string]string{
+				"main.tf": `provider "aws" {}`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "terraform block only",
+			Content: map[string]string{
+				"versions.tf": `
+terraform {
+  required_version = ">= 1.0"
+}
+`,
+			},
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "other blocks",
+			Content: map[string]string{
+				"main.tf":      "",
+				"variables.tf": "",
+				"versions.tf": `
+terraform {
+  required_version = ">= 1.0"
+}
+
+provider "aws" {
+  region = var.region
+}
+
+variable "region" {}
+`,
+			},
+			Expected: helper.Issues{
+				{
+					Rule:    NewVersionsFileTerraformOnlyRule(),
+					Message: "provider block should be moved from versions.tf to main.tf",
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 15},
+					},
+				},
+				{
+					Rule:    NewVersionsFileTerraformOnlyRule(),
+					Message: "variable block should be moved from versions.tf to variables.tf",
+					Range: hcl.Range{
+						Filename: "versions.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 18},
+					},
+				},
+			},
+			Fixed: map[string]string{
+				"main.tf": `provider "aws" {
+  region = var.region
+}
+`,
+				"variables.tf": `variable "region" {}
+`,
+				"versions.tf": `
+terraform {
+  required_version = ">= 1.0"
+}
+
+`,
+			},
+		},
+	}
+
+	rule := NewVersionsFileTerraformOnlyRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, tc.Content)
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+			if tc.Fixed != nil {
+				helper.AssertChanges(t, tc.Fixed, runner.Changes())
+			}
+		})
+	}
+}
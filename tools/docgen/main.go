@@ -0,0 +1,124 @@
+// Command docgen generates docs/rules/<name>.md for every rule in the ruleset
+// from the metadata the rules return. It fails if a rule is not documented.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/jforde/tflint-ruleset-hackathon/rules"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var pageTemplate = template.Must(template.New("page").Funcs(template.FuncMap{"join": strings.Join, "cell": cell}).Parse(`<!-- Code generated by tools/docgen. DO NOT EDIT. -->
+# {{ .Name }}
+
+{{ .Description }}
+
+|Severity|Enabled by default|Tags|
+| --- | --- | --- |
+|{{ .Severity }}|{{ if .Enabled }}✔{{ end }}|{{ join .Tags ", " }}|
+
+## Example
+
+` + "```hcl" + `
+{{ .Example }}
+` + "```" + `
+
+## Configuration
+
+` + "```hcl" + `
+rule "{{ .Name }}" {
+  enabled = true
+}
+` + "```" + `
+{{ if .Config }}
+|Name|Description|Default|
+| --- | --- | --- |
+{{- range .Config }}
+|{{ .Name }}|{{ cell .Description }}|{{ if .Default }}` + "`{{ cell .Default }}`" + `{{ end }}|
+{{- end }}
+{{ else }}
+This rule has no options.
+{{ end -}}
+{{ if .References }}
+## References
+{{ range .References }}
+- {{ . }}
+{{- end }}
+{{ end -}}
+`))
+
+// cell escapes the pipes of a table cell, which end the cell even inside code spans
+func cell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+// page is the data rendered into a rule page
+type page struct {
+	*rules.Metadata
+	Name     string
+	Severity string
+	Enabled  bool
+}
+
+func main() {
+	out := flag.String("out", filepath.Join("docs", "rules"), "directory to write the rule pages to")
+	flag.Parse()
+
+	if err := generate(rules.Rules, *out); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate writes a page for every rule into dir, or returns an error listing the rules without metadata
+func generate(ruleset []tflint.Rule, dir string) error {
+	pages := make([]page, 0, len(ruleset))
+	var errs []error
+	for _, rule := range ruleset {
+		meta, ok := rule.Metadata().(*rules.Metadata)
+		switch {
+		case !ok || meta == nil:
+			errs = append(errs, fmt.Errorf("%s: Metadata() does not return *rules.Metadata", rule.Name()))
+			continue
+		case meta.Description == "":
+			errs = append(errs, fmt.Errorf("%s: metadata has no description", rule.Name()))
+		case len(meta.Tags) == 0:
+			errs = append(errs, fmt.Errorf("%s: metadata has no tags", rule.Name()))
+		case strings.TrimSpace(meta.Example) == "":
+			errs = append(errs, fmt.Errorf("%s: metadata has no example", rule.Name()))
+		}
+		pages = append(pages, page{
+			Metadata: meta,
+			Name:     rule.Name(),
+			Severity: strings.ToUpper(rule.Severity().String()),
+			Enabled:  rule.Enabled(),
+		})
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, p := range pages {
+		p.Example = strings.Trim(p.Example, "\n")
+
+		var buf bytes.Buffer
+		if err := pageTemplate.Execute(&buf, p); err != nil {
+			return fmt.Errorf("%s: %w", p.Name, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, p.Name+".md"), buf.Bytes(), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
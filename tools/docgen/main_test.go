@@ -0,0 +1,135 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jforde/tflint-ruleset-hackathon/rules"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// testRule is a rule with configurable metadata
+type testRule struct {
+	tflint.DefaultRule
+	metadata interface{}
+}
+
+func (r *testRule) Name() string              { return "test_rule" }
+func (r *testRule) Enabled() bool             { return true }
+func (r *testRule) Severity() tflint.Severity { return tflint.NOTICE }
+func (r *testRule) Metadata() interface{}     { return r.metadata }
+func (r *testRule) Check(tflint.Runner) error { return nil }
+
+func Test_generate(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Metadata interface{}
+		Expected string
+		Error    string
+	}{
+		{
+			Name: "documented",
+			Metadata: &rules.Metadata{
+				Description: "Test rule",
+				Tags:        []string{rules.TagStyle},
+				Config: []rules.ConfigOption{
+					{Name: "max", Description: "Maximum", Default: "1"},
+				},
+				Example: `
+resource "foo" "bar" {}
+`,
+			},
+			Expected: "<!-- Code generated by tools/docgen. DO NOT EDIT. -->\n" +
+				"# test_rule\n\nTest rule\n\n" +
+				"|Severity|Enabled by default|Tags|\n| --- | --- | --- |\n|NOTICE|✔|style|\n\n" +
+				"## Example\n\n```hcl\nresource \"foo\" \"bar\" {}\n```\n\n" +
+				"## Configuration\n\n```hcl\nrule \"test_rule\" {\n  enabled = true\n}\n```\n\n" +
+				"|Name|Description|Default|\n| --- | --- | --- |\n|max|Maximum|`1`|\n",
+		},
+		{
+			Name: "pipes in config",
+			Metadata: &rules.Metadata{
+				Description: "Test rule",
+				Tags:        []string{rules.TagStyle},
+				Config: []rules.ConfigOption{
+					{Name: "pattern", Description: "Pattern", Default: "^(a|b)$"},
+				},
+				Example: `
+resource "foo" "bar" {}
+`,
+			},
+			Expected: "<!-- Code generated by tools/docgen. DO NOT EDIT. -->\n" +
+				"# test_rule\n\nTest rule\n\n" +
+				"|Severity|Enabled by default|Tags|\n| --- | --- | --- |\n|NOTICE|✔|style|\n\n" +
+				"## Example\n\n```hcl\nresource \"foo\" \"bar\" {}\n```\n\n" +
+				"## Configuration\n\n```hcl\nrule \"test_rule\" {\n  enabled = true\n}\n```\n\n" +
+				"|Name|Description|Default|\n| --- | --- | --- |\n|pattern|Pattern|`^(a\\|b)$`|\n",
+		},
+		{
+			Name: "references",
+			Metadata: &rules.Metadata{
+				Description: "Test rule",
+				Tags:        []string{rules.TagStyle},
+				Example: `
+resource "foo" "bar" {}
+`,
+				References: []string{"https://example.com/a", "https://example.com/b"},
+			},
+			Expected: "<!-- Code generated by tools/docgen. DO NOT EDIT. -->\n" +
+				"# test_rule\n\nTest rule\n\n" +
+				"|Severity|Enabled by default|Tags|\n| --- | --- | --- |\n|NOTICE|✔|style|\n\n" +
+				"## Example\n\n```hcl\nresource \"foo\" \"bar\" {}\n```\n\n" +
+				"## Configuration\n\n```hcl\nrule \"test_rule\" {\n  enabled = true\n}\n```\n\n" +
+				"This rule has no options.\n\n" +
+				"## References\n\n- https://example.com/a\n- https://example.com/b\n",
+		},
+		{
+			Name:     "no metadata",
+			Metadata: nil,
+			Error:    "test_rule: Metadata() does not return *rules.Metadata",
+		},
+		{
+			Name:     "no tags",
+			Metadata: &rules.Metadata{Description: "Test rule", Example: "foo"},
+			Error:    "test_rule: metadata has no tags",
+		},
+		{
+			Name:     "no example",
+			Metadata: &rules.Metadata{Description: "Test rule", Tags: []string{rules.TagStyle}},
+			Error:    "test_rule: metadata has no example",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			err := generate([]tflint.Rule{&testRule{metadata: tc.Metadata}}, dir)
+			if tc.Error != "" {
+				if err == nil || err.Error() != tc.Error {
+					t.Fatalf("Expected error %q, got %v", tc.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			got, err := os.ReadFile(filepath.Join(dir, "test_rule.md"))
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if string(got) != tc.Expected {
+				t.Errorf("Expected page:\n%s\ngot:\n%s", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func Test_generate_AllRulesDocumented(t *testing.T) {
+	if err := generate(rules.Rules, t.TempDir()); err != nil {
+		t.Fatalf("Every rule must be documented:\n%s", strings.ReplaceAll(err.Error(), "\n", "\n  "))
+	}
+}
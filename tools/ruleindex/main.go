@@ -0,0 +1,91 @@
+// Command ruleindex prints every rule in the ruleset as JSON, with its severity, default state, tags and
+// configuration options, so that the capabilities of a build can be ingested and compared by other tools.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/jforde/tflint-ruleset-hackathon/project"
+	"github.com/jforde/tflint-ruleset-hackathon/rules"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// index is the document printed by the command
+type index struct {
+	Version string              `json:"version"`
+	Presets map[string][]string `json:"presets"`
+	Rules   []ruleEntry         `json:"rules"`
+}
+
+// ruleEntry describes a rule of the index
+type ruleEntry struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Severity    string        `json:"severity"`
+	Enabled     bool          `json:"enabled"`
+	Tags        []string      `json:"tags"`
+	Link        string        `json:"link"`
+	Config      []configEntry `json:"config"`
+}
+
+// configEntry describes an attribute of the rule block
+type configEntry struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Default     string `json:"default"`
+}
+
+func main() {
+	out := flag.String("out", "", "file to write the index to, defaults to stdout")
+	flag.Parse()
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := write(w, rules.Rules); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// write encodes the index of the rules to w, in the order the rules are registered
+func write(w io.Writer, ruleset []tflint.Rule) error {
+	idx := index{
+		Version: project.Version,
+		Presets: rules.Presets,
+		Rules:   make([]ruleEntry, 0, len(ruleset)),
+	}
+	for _, rule := range ruleset {
+		entry := ruleEntry{
+			Name:     rule.Name(),
+			Severity: strings.ToUpper(rule.Severity().String()),
+			Enabled:  rule.Enabled(),
+			Tags:     []string{},
+			Link:     rule.Link(),
+			Config:   []configEntry{},
+		}
+		if meta, ok := rule.Metadata().(*rules.Metadata); ok && meta != nil {
+			entry.Description = meta.Description
+			entry.Tags = append(entry.Tags, meta.Tags...)
+			for _, option := range meta.Config {
+				entry.Config = append(entry.Config, configEntry{Name: option.Name, Description: option.Description, Default: option.Default})
+			}
+		}
+		idx.Rules = append(idx.Rules, entry)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(idx)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/jforde/tflint-ruleset-hackathon/rules"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// testRule is a rule with configurable metadata
+type testRule struct {
+	tflint.DefaultRule
+	metadata interface{}
+}
+
+func (r *testRule) Name() string              { return "test_rule" }
+func (r *testRule) Enabled() bool             { return false }
+func (r *testRule) Severity() tflint.Severity { return tflint.WARNING }
+func (r *testRule) Link() string              { return "https://example.com/test_rule" }
+func (r *testRule) Metadata() interface{}     { return r.metadata }
+func (r *testRule) Check(tflint.Runner) error { return nil }
+
+func Test_write(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Metadata interface{}
+		Expected ruleEntry
+	}{
+		{
+			Name: "documented",
+			Metadata: &rules.Metadata{
+				Description: "Test rule",
+				Tags:        []string{rules.TagStyle},
+				Config: []rules.ConfigOption{
+					{Name: "max", Description: "Maximum", Default: "1"},
+				},
+			},
+			Expected: ruleEntry{
+				Name:        "test_rule",
+				Description: "Test rule",
+				Severity:    "WARNING",
+				Enabled:     false,
+				Tags:        []string{"style"},
+				Link:        "https://example.com/test_rule",
+				Config:      []configEntry{{Name: "max", Description: "Maximum", Default: "1"}},
+			},
+		},
+		{
+			Name:     "no metadata",
+			Metadata: nil,
+			Expected: ruleEntry{
+				Name:     "test_rule",
+				Severity: "WARNING",
+				Tags:     []string{},
+				Link:     "https://example.com/test_rule",
+				Config:   []configEntry{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := write(&buf, []tflint.Rule{&testRule{metadata: tc.Metadata}}); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			var got index
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if !reflect.DeepEqual([]ruleEntry{tc.Expected}, got.Rules) {
+				t.Errorf("Expected rules %+v, got %+v", []ruleEntry{tc.Expected}, got.Rules)
+			}
+			if !reflect.DeepEqual(rules.Presets, got.Presets) {
+				t.Errorf("Expected presets %v, got %v", rules.Presets, got.Presets)
+			}
+		})
+	}
+}